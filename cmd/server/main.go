@@ -7,6 +7,7 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
+	client "github.com/sh5080/ndns-go/pkg/clients"
 	"github.com/sh5080/ndns-go/pkg/configs"
 	middleware "github.com/sh5080/ndns-go/pkg/middlewares"
 	route "github.com/sh5080/ndns-go/pkg/routes"
@@ -17,6 +18,9 @@ func main() {
 	// 메트릭 초기화
 	utils.InitMetrics()
 
+	// ngrok 터널에서 워커 주소 찾기 (NGROK_WORKER_TUNNELS 설정 시)
+	client.StartNgrokWorkerResolver(configs.GetConfig())
+
 	app := fiber.New(fiber.Config{
 		AppName: "NDNS-GO Service",
 	})
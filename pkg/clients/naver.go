@@ -10,6 +10,7 @@ import (
 
 	"github.com/sh5080/ndns-go/pkg/configs"
 	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+	"github.com/sh5080/ndns-go/pkg/transport"
 	structure "github.com/sh5080/ndns-go/pkg/types/structures"
 )
 
@@ -22,18 +23,36 @@ type NaverAPIClient struct {
 func NewNaverAPIClient(config *configs.EnvConfig) *NaverAPIClient {
 	return &NaverAPIClient{
 		Service: _interface.Service{
-			Client: &http.Client{
-				Timeout: time.Second * 10, // 10초 타임아웃
-			},
+			Client: transport.NewClient(time.Second * 10), // 10초 타임아웃
 			Config: config,
 		},
 	}
 }
 
+// Search는 검색 대상(source)에 맞는 네이버 검색 API를 호출하여 결과를 반환합니다.
+func (c *NaverAPIClient) Search(source structure.SearchSource, query string, display int, start int) (*structure.NaverSearchResponse, error) {
+	switch source {
+	case structure.SearchSourceCafe:
+		return c.SearchCafeArticle(query, display, start)
+	case structure.SearchSourceBlog, "":
+		return c.SearchBlog(query, display, start)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 검색 대상입니다: %s", source)
+	}
+}
+
 // SearchBlog는 네이버 블로그 검색 API를 호출하여 결과를 반환합니다.
 func (c *NaverAPIClient) SearchBlog(query string, display int, start int) (*structure.NaverSearchResponse, error) {
-	searchURL := c.Config.Naver.SearchURL
+	return c.search(c.Config.Naver.SearchURL, query, display, start)
+}
+
+// SearchCafeArticle은 네이버 카페글 검색 API를 호출하여 결과를 반환합니다.
+func (c *NaverAPIClient) SearchCafeArticle(query string, display int, start int) (*structure.NaverSearchResponse, error) {
+	return c.search(c.Config.Naver.CafeSearchURL, query, display, start)
+}
 
+// search는 네이버 검색 API 엔드포인트를 호출하는 공통 함수입니다.
+func (c *NaverAPIClient) search(searchURL string, query string, display int, start int) (*structure.NaverSearchResponse, error) {
 	params := url.Values{}
 	params.Add("query", query)
 	params.Add("display", fmt.Sprintf("%d", display))
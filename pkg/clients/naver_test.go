@@ -0,0 +1,56 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sh5080/ndns-go/pkg/configs"
+	"github.com/sh5080/ndns-go/pkg/transport"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestNaverAPIClientSearchSource(t *testing.T) {
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"total":1,"start":1,"display":1,"items":[{"title":"후기","link":"https://cafe.naver.com/foodclub/1234"}]}`))
+	}))
+	defer server.Close()
+
+	config := &configs.EnvConfig{}
+	config.Naver.SearchURL = server.URL + "/v1/search/blog.json"
+	config.Naver.CafeSearchURL = server.URL + "/v1/search/cafearticle.json"
+	client := NewNaverAPIClient(config)
+
+	tests := []struct {
+		source structure.SearchSource
+		want   string
+	}{
+		{structure.SearchSourceCafe, "/v1/search/cafearticle.json"},
+		{structure.SearchSourceBlog, "/v1/search/blog.json"},
+		{"", "/v1/search/blog.json"},
+	}
+
+	for _, tt := range tests {
+		requested = ""
+		resp, err := client.Search(tt.source, "망원동 파스타", 10, 1)
+		if err != nil {
+			t.Fatalf("Search(%q) 에러: %v", tt.source, err)
+		}
+		if requested != tt.want {
+			t.Errorf("Search(%q) 요청 경로 = %q, want %q", tt.source, requested, tt.want)
+		}
+		if len(resp.Items) != 1 {
+			t.Errorf("Search(%q) 결과 수 = %d, want 1", tt.source, len(resp.Items))
+		}
+	}
+
+	if _, err := client.Search("news", "망원동 파스타", 10, 1); err == nil {
+		t.Error("지원하지 않는 검색 대상에 에러가 없습니다")
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/sh5080/ndns-go/pkg/configs"
 	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+	"github.com/sh5080/ndns-go/pkg/transport"
 	structure "github.com/sh5080/ndns-go/pkg/types/structures"
 )
 
@@ -22,21 +24,23 @@ type NgrokAPIClient struct {
 // NewNgrokAPIClient는 새로운 ngrok API 클라이언트를 생성합니다.
 func NewNgrokAPIClient(config *configs.EnvConfig) *NgrokAPIClient {
 
-	ngrokAPIURL := "http://localhost:4040"
-	// Docker 환경인지 확인
-	if _, err := os.Stat("/.dockerenv"); err == nil {
-		// Docker 컨테이너 내부
-		ngrokAPIURL = "http://host.docker.internal:4040"
+	ngrokAPIURL := config.Ngrok.APIURL
+	if ngrokAPIURL == "" {
+		ngrokAPIURL = "http://localhost:4040"
+		// Docker 환경인지 확인
+		if _, err := os.Stat("/.dockerenv"); err == nil {
+			// Docker 컨테이너 내부
+			ngrokAPIURL = "http://host.docker.internal:4040"
+		}
 	}
 
 	return &NgrokAPIClient{
 		Service: _interface.Service{
-			Client: &http.Client{
-				Timeout: time.Second * 5, // 5초 타임아웃
-			},
+			// 로컬 에이전트 API는 요청 제한, 요청 경로 전환 없이 직접 호출 (워커 주소를 찾는 데 워커를 거치지 않도록)
+			Client: transport.NewDirectClient(time.Second * 5), // 5초 타임아웃
 			Config: config,
 		},
-		BaseURL: ngrokAPIURL,
+		BaseURL: strings.TrimRight(ngrokAPIURL, "/"),
 	}
 }
 
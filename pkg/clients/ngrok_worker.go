@@ -0,0 +1,184 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sh5080/ndns-go/pkg/configs"
+	"github.com/sh5080/ndns-go/pkg/transport"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// NgrokWorkerResolver는 ngrok 에이전트 API에서 워커 터널의 공개 주소를 찾아 요청 경로에 반영합니다
+// 터널이 다시 시작되어 공개 주소가 바뀌어도 주기적으로 다시 찾으므로 서버를 재시작할 필요가 없습니다
+type NgrokWorkerResolver struct {
+	client   *NgrokAPIClient
+	tunnels  map[string]string // 경로 이름 → 터널 이름 또는 로컬 주소
+	interval time.Duration
+	resolved map[string]string // 경로 이름 → 마지막으로 반영한 공개 주소
+	lock     sync.Mutex
+	stop     chan struct{}
+}
+
+// ParseWorkerTunnels는 "경로이름=터널이름 또는 로컬주소"를 쉼표로 구분한 목록을 파싱합니다
+// 경로 이름을 생략하면 worker 경로로 간주합니다 (예: "ocr-worker", "worker=ocr,backup=localhost:8081")
+func ParseWorkerTunnels(value string) (map[string]string, error) {
+	tunnels := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		route, tunnel, found := strings.Cut(item, "=")
+		if !found {
+			route, tunnel = string(transport.RouteKindWorker), item
+		}
+		route, tunnel = strings.TrimSpace(route), strings.TrimSpace(tunnel)
+		if route == "" || tunnel == "" {
+			return nil, fmt.Errorf("잘못된 워커 터널 설정입니다: %q", item)
+		}
+		if _, exists := tunnels[route]; exists {
+			return nil, fmt.Errorf("워커 터널 경로 이름이 중복되었습니다: %q", route)
+		}
+		tunnels[route] = tunnel
+	}
+	return tunnels, nil
+}
+
+// NewNgrokWorkerResolver는 새 워커 주소 탐색기를 생성합니다
+func NewNgrokWorkerResolver(client *NgrokAPIClient, tunnels map[string]string, interval time.Duration) *NgrokWorkerResolver {
+	return &NgrokWorkerResolver{
+		client:   client,
+		tunnels:  tunnels,
+		interval: interval,
+		resolved: make(map[string]string),
+	}
+}
+
+// StartNgrokWorkerResolver는 NGROK_WORKER_TUNNELS 설정에 따라 워커 주소 탐색을 시작합니다
+// 설정이 없거나 잘못되었으면 nil을 반환하며, 이때 워커 경로는 WORKER_URL을 그대로 사용합니다
+func StartNgrokWorkerResolver(config *configs.EnvConfig) *NgrokWorkerResolver {
+	if config.Ngrok.WorkerTunnels == "" {
+		return nil
+	}
+
+	tunnels, err := ParseWorkerTunnels(config.Ngrok.WorkerTunnels)
+	if err != nil {
+		fmt.Printf("ngrok 워커 터널 설정 실패 (WORKER_URL 사용): %v\n", err)
+		return nil
+	}
+
+	resolver := NewNgrokWorkerResolver(NewNgrokAPIClient(config), tunnels, config.Ngrok.RefreshInterval)
+	resolver.Start()
+	return resolver
+}
+
+// Start는 워커 주소를 바로 한 번 찾고, 이후 설정한 주기마다 다시 찾습니다
+func (r *NgrokWorkerResolver) Start() {
+	if err := r.Refresh(); err != nil {
+		fmt.Printf("ngrok 워커 주소 찾기 실패 (다음 주기에 다시 시도): %v\n", err)
+	}
+	if r.interval <= 0 {
+		return
+	}
+
+	r.stop = make(chan struct{})
+	go func(stop <-chan struct{}) {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := r.Refresh(); err != nil {
+					fmt.Printf("ngrok 워커 주소 찾기 실패 (이전 주소 유지): %v\n", err)
+				}
+			case <-stop:
+				return
+			}
+		}
+	}(r.stop)
+}
+
+// Stop은 주기적인 워커 주소 탐색을 멈춥니다
+func (r *NgrokWorkerResolver) Stop() {
+	if r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+}
+
+// Refresh는 ngrok 터널 목록에서 워커 경로별 공개 주소를 찾아 요청 경로에 반영합니다
+// 에이전트 API 호출에 실패하면 이전 주소를 유지하고, 터널이 목록에서 사라지면 WORKER_URL로 되돌립니다
+func (r *NgrokWorkerResolver) Refresh() error {
+	response, err := r.client.GetTunnels()
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for route, tunnel := range r.tunnels {
+		publicURL := findTunnelURL(response.Tunnels, tunnel)
+		if publicURL == r.resolved[route] {
+			continue
+		}
+
+		if publicURL == "" {
+			fmt.Printf("ngrok 워커 터널을 찾지 못했습니다: %s (%s), WORKER_URL 사용\n", route, tunnel)
+			delete(r.resolved, route)
+		} else {
+			fmt.Printf("ngrok 워커 주소 반영: %s → %s\n", route, publicURL)
+			r.resolved[route] = publicURL
+		}
+		transport.SetRouteURL(route, publicURL)
+	}
+	return nil
+}
+
+// Resolved는 경로 이름별로 현재 반영된 공개 주소를 반환합니다
+func (r *NgrokWorkerResolver) Resolved() map[string]string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	resolved := make(map[string]string, len(r.resolved))
+	for route, publicURL := range r.resolved {
+		resolved[route] = publicURL
+	}
+	return resolved
+}
+
+// findTunnelURL은 이름 또는 로컬 주소가 일치하는 터널의 공개 주소를 찾습니다
+// 같은 로컬 주소로 http, https 터널이 함께 열려 있으면 https 주소를 사용합니다
+func findTunnelURL(tunnels []structure.NgrokTunnel, want string) string {
+	var found string
+	for _, tunnel := range tunnels {
+		if tunnel.Name != want && normalizeTunnelAddr(tunnel.Config.Addr) != normalizeTunnelAddr(want) {
+			continue
+		}
+		if tunnel.Proto == "https" || strings.HasPrefix(tunnel.PublicURL, "https://") {
+			return tunnel.PublicURL
+		}
+		if found == "" {
+			found = tunnel.PublicURL
+		}
+	}
+	return found
+}
+
+// normalizeTunnelAddr는 "http://localhost:8080", "localhost:8080", "8080"을 같은 주소로 비교할 수 있게 바꿉니다
+func normalizeTunnelAddr(addr string) string {
+	addr = strings.TrimSpace(strings.ToLower(addr))
+	if _, rest, found := strings.Cut(addr, "://"); found {
+		addr = rest
+	}
+	addr = strings.TrimRight(addr, "/")
+	if addr != "" && !strings.Contains(addr, ":") {
+		addr = "localhost:" + addr
+	}
+	return strings.Replace(addr, "127.0.0.1:", "localhost:", 1)
+}
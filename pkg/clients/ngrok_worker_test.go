@@ -0,0 +1,166 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestParseWorkerTunnels(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{"", map[string]string{}, false},
+		{"ocr-worker", map[string]string{"worker": "ocr-worker"}, false},
+		{" worker = ocr , backup=localhost:8081 ,", map[string]string{"worker": "ocr", "backup": "localhost:8081"}, false},
+		{"8080", map[string]string{"worker": "8080"}, false},
+		{"backup=", nil, true},
+		{"=ocr", nil, true},
+		{"ocr,worker=backup", nil, true}, // 경로 이름을 생략한 항목도 worker로 중복
+	}
+
+	for _, tt := range tests {
+		got, err := ParseWorkerTunnels(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseWorkerTunnels(%q) 에러 = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseWorkerTunnels(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+// newTunnel은 테스트용 ngrok 터널 정보를 생성합니다
+func newTunnel(name, proto, publicURL, addr string) structure.NgrokTunnel {
+	tunnel := structure.NgrokTunnel{Name: name, Proto: proto, PublicURL: publicURL}
+	tunnel.Config.Addr = addr
+	return tunnel
+}
+
+func TestFindTunnelURL(t *testing.T) {
+	tunnels := []structure.NgrokTunnel{
+		newTunnel("ocr (http)", "http", "http://ocr.ngrok.app", "http://localhost:8080"),
+		newTunnel("ocr", "https", "https://ocr.ngrok.app", "http://localhost:8080"),
+		newTunnel("backup", "http", "http://backup.ngrok.app", "http://127.0.0.1:8081"),
+		newTunnel("admin", "https", "https://admin.ngrok.app", "localhost:9000"),
+	}
+
+	tests := []struct {
+		want     string
+		expected string
+	}{
+		{"ocr", "https://ocr.ngrok.app"},                      // 터널 이름
+		{"8080", "https://ocr.ngrok.app"},                     // 포트만 지정해도 로컬 주소로 비교, https 우선
+		{"http://127.0.0.1:8080", "https://ocr.ngrok.app"},    // 127.0.0.1과 localhost는 같은 주소
+		{"ocr (http)", "http://ocr.ngrok.app"},                // 이름이 일치하는 터널
+		{"localhost:8081", "http://backup.ngrok.app"},         // https 터널이 없으면 http 주소
+		{"HTTP://LOCALHOST:9000/", "https://admin.ngrok.app"}, // 스킴, 대소문자, 끝의 / 무시
+		{"missing", ""},
+		{"8082", ""},
+	}
+
+	for _, tt := range tests {
+		if got := findTunnelURL(tunnels, tt.want); got != tt.expected {
+			t.Errorf("findTunnelURL(%q) = %q, want %q", tt.want, got, tt.expected)
+		}
+	}
+}
+
+func TestNormalizeTunnelAddr(t *testing.T) {
+	tests := map[string]string{
+		"8080":                    "localhost:8080",
+		"localhost:8080":          "localhost:8080",
+		"http://127.0.0.1:8080":   "localhost:8080",
+		"https://localhost:8080/": "localhost:8080",
+		"":                        "",
+	}
+
+	for addr, want := range tests {
+		if got := normalizeTunnelAddr(addr); got != want {
+			t.Errorf("normalizeTunnelAddr(%q) = %q, want %q", addr, got, want)
+		}
+	}
+}
+
+func TestNgrokWorkerResolverRefresh(t *testing.T) {
+	transport.SetDirectTransport(http.DefaultTransport)
+	defer transport.SetDirectTransport(nil)
+	t.Cleanup(func() {
+		transport.SetRouteURL("worker", "")
+		transport.SetRouteURL("backup", "")
+	})
+
+	// 에이전트 API가 반환할 터널 목록 (테스트 중간에 바꿈)
+	var lock sync.Mutex
+	var tunnels []structure.NgrokTunnel
+	agent := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/tunnels" {
+			http.NotFound(w, r)
+			return
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		json.NewEncoder(w).Encode(structure.NgrokTunnelsResponse{Tunnels: tunnels})
+	}))
+	defer agent.Close()
+	setTunnels := func(list ...structure.NgrokTunnel) {
+		lock.Lock()
+		defer lock.Unlock()
+		tunnels = list
+	}
+
+	client := &NgrokAPIClient{BaseURL: agent.URL}
+	client.Client = transport.NewDirectClient(0)
+	resolver := NewNgrokWorkerResolver(client, map[string]string{"worker": "ocr", "backup": "8081"}, 0)
+
+	worker := transport.EgressRoute{Name: "worker", Kind: transport.RouteKindWorker, URL: "https://configured-worker.example.com"}
+	backup := transport.EgressRoute{Name: "backup", Kind: transport.RouteKindWorker, URL: "https://configured-backup.example.com"}
+
+	// 처음 찾은 주소를 요청 경로에 반영
+	setTunnels(
+		newTunnel("ocr", "https", "https://first.ngrok.app", "http://localhost:8080"),
+		newTunnel("backup", "https", "https://backup.ngrok.app", "http://localhost:8081"),
+	)
+	if err := resolver.Refresh(); err != nil {
+		t.Fatalf("Refresh 에러: %v", err)
+	}
+	if got := worker.CurrentURL(); got != "https://first.ngrok.app" {
+		t.Errorf("worker 경로 주소 = %q, want https://first.ngrok.app", got)
+	}
+	if got := backup.CurrentURL(); got != "https://backup.ngrok.app" {
+		t.Errorf("backup 경로 주소 = %q, want https://backup.ngrok.app", got)
+	}
+
+	// 터널이 다시 시작되어 주소가 바뀌면 새 주소를, 터널이 사라지면 설정한 주소를 사용
+	setTunnels(newTunnel("ocr", "https", "https://rotated.ngrok.app", "http://localhost:8080"))
+	if err := resolver.Refresh(); err != nil {
+		t.Fatalf("Refresh 에러: %v", err)
+	}
+	if got := worker.CurrentURL(); got != "https://rotated.ngrok.app" {
+		t.Errorf("주소가 바뀐 worker 경로 주소 = %q, want https://rotated.ngrok.app", got)
+	}
+	if got := backup.CurrentURL(); got != backup.URL {
+		t.Errorf("터널이 사라진 backup 경로 주소 = %q, want %q", got, backup.URL)
+	}
+	if got, want := resolver.Resolved(), map[string]string{"worker": "https://rotated.ngrok.app"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Resolved = %v, want %v", got, want)
+	}
+
+	// 에이전트 API 호출에 실패하면 이전 주소 유지
+	agent.Close()
+	if err := resolver.Refresh(); err == nil {
+		t.Error("에이전트 API가 없는데 Refresh 에러가 없습니다")
+	}
+	if got := worker.CurrentURL(); got != "https://rotated.ngrok.app" {
+		t.Errorf("API 실패 후 worker 경로 주소 = %q, want https://rotated.ngrok.app", got)
+	}
+}
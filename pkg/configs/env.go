@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/caarlos0/env/v6"
 	"github.com/joho/godotenv"
@@ -32,13 +33,48 @@ type EnvConfig struct {
 		}
 	}
 	Naver struct {
-		ClientID     string `env:"NAVER_CLIENT_ID,required"`
-		ClientSecret string `env:"NAVER_CLIENT_SECRET,required"`
-		SearchURL    string `env:"NAVER_SEARCH_URL" envDefault:"https://openapi.naver.com/v1/search/blog.json"`
+		ClientID      string `env:"NAVER_CLIENT_ID,required"`
+		ClientSecret  string `env:"NAVER_CLIENT_SECRET,required"`
+		SearchURL     string `env:"NAVER_SEARCH_URL" envDefault:"https://openapi.naver.com/v1/search/blog.json"`
+		CafeSearchURL string `env:"NAVER_CAFE_SEARCH_URL" envDefault:"https://openapi.naver.com/v1/search/cafearticle.json"`
+	}
+	Ngrok struct {
+		APIURL string `env:"NGROK_API_URL" envDefault:""` // 빈 값이면 로컬 에이전트 (Docker에서는 host.docker.internal)
+		// 워커 경로별 ngrok 터널 ("경로이름=터널이름 또는 로컬주소"를 쉼표로 구분, 경로 이름을 생략하면 worker), 빈 값이면 WORKER_URL만 사용
+		WorkerTunnels   string        `env:"NGROK_WORKER_TUNNELS" envDefault:""`
+		RefreshInterval time.Duration `env:"NGROK_REFRESH_INTERVAL" envDefault:"30s"`
 	}
 	OCR struct {
-		TesseractPath string `env:"OCR_TESSERACT_PATH" envDefault:"/usr/local/bin/tesseract"`
+		Engine        string `env:"OCR_ENGINE" envDefault:"tesseract"`                        // OCR 엔진 (tesseract, remote, fake)
+		TesseractPath string `env:"OCR_TESSERACT_PATH" envDefault:"/usr/local/bin/tesseract"` // 파일이 없으면 PATH의 tesseract 사용
+		Languages     string `env:"OCR_LANGUAGES" envDefault:"kor"`                           // Tesseract 언어 (여러 개는 kor+eng 형식)
+		TessdataDir   string `env:"OCR_TESSDATA_DIR" envDefault:""`                           // 빈 값이면 TESSDATA_PREFIX 또는 Tesseract 기본 경로
+		RemoteURL     string `env:"OCR_REMOTE_URL" envDefault:""`                             // remote 엔진의 OCR 서비스 주소 (NGROK_WORKER_TUNNELS에 ocr 경로가 있으면 터널 주소로 바꿔 사용)
 		TempDir       string `env:"OCR_TEMP_DIR" envDefault:"/tmp"`
+		// OCR 결과 저장소 (memory, bolt, dynamodb, redis), 빈 값이면 DynamoDB 테이블이 설정되어 있으면 dynamodb, 아니면 memory
+		CacheBackend string `env:"OCR_CACHE_BACKEND" envDefault:""`
+		// 프로세스 메모리에 보관하는 OCR 결과 최대 개수 (다른 저장소를 사용하면 그 앞 단계로 사용)
+		CacheMaxEntries int    `env:"OCR_CACHE_MAX_ENTRIES" envDefault:"10000"`
+		CacheFile       string `env:"OCR_CACHE_FILE" envDefault:"/tmp/ndns-ocr-cache.db"` // bolt 저장소 파일
+		CacheRedisURL   string `env:"OCR_CACHE_REDIS_URL" envDefault:""`                  // redis 저장소 주소 (redis://host:6379/0)
+		// 동시에 실행하는 OCR 작업 수 (0이면 CPU 코어 수)
+		Workers      int           `env:"OCR_WORKERS" envDefault:"0"`
+		QueueSize    int           `env:"OCR_QUEUE_SIZE" envDefault:"32"`     // 작업자를 기다리는 OCR 요청 최대 개수 (넘으면 거절)
+		QueueTimeout time.Duration `env:"OCR_QUEUE_TIMEOUT" envDefault:"10s"` // 대기열에서 기다리는 최대 시간
+	}
+	PageCache struct {
+		Dir       string        `env:"PAGE_CACHE_DIR" envDefault:"/tmp/ndns-page-cache"` // 빈 값이면 페이지 캐시 사용 안 함
+		MaxAge    time.Duration `env:"PAGE_CACHE_MAX_AGE" envDefault:"6h"`               // 재검증 없이 사용하는 시간
+		MaxSizeMB int64         `env:"PAGE_CACHE_MAX_SIZE_MB" envDefault:"256"`
+	}
+	HTTP struct {
+		CassetteMode string `env:"HTTP_CASSETTE_MODE" envDefault:"off"` // off, record, replay
+		CassetteDir  string `env:"HTTP_CASSETTE_DIR" envDefault:"testdata/cassettes"`
+		// 호스트 패턴별 요청 제한 ("패턴=초당요청수:버스트:동시요청수"를 쉼표로 구분, 앞쪽 규칙 우선)
+		HostLimits string `env:"HTTP_HOST_LIMITS" envDefault:"blog.naver.com=5:10:4,m.blog.naver.com=5:10:4,*.pstatic.net=20:20:8"`
+		MaxRetries int    `env:"HTTP_MAX_RETRIES" envDefault:"3"`
+		// 외부 요청 경로 ("direct" 또는 "이름=워커주소"를 쉼표로 구분, 앞쪽 경로 우선, 주소 없는 "worker"는 WORKER_URL 사용)
+		EgressRoutes string `env:"HTTP_EGRESS_ROUTES" envDefault:"direct,worker"`
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	client "github.com/sh5080/ndns-go/pkg/clients"
 	"github.com/sh5080/ndns-go/pkg/configs"
+	"github.com/sh5080/ndns-go/pkg/transport"
 	responseDto "github.com/sh5080/ndns-go/pkg/types/dtos/responses"
 )
 
@@ -31,6 +32,18 @@ func Health() fiber.Handler {
 			Uptime:    time.Since(startTime).String(),
 			GoVersion: GoVersion,
 		}
+
+		// 차단 대기 중인 호스트 표시
+		for _, status := range transport.BlockedHosts() {
+			response.Blocked = true
+			response.BlockedHosts = append(response.BlockedHosts, responseDto.BlockedHost{
+				Host:    status.Host,
+				Reason:  string(status.Reason),
+				Since:   status.Since,
+				Until:   status.Until,
+				Strikes: status.Strikes,
+			})
+		}
 		return c.JSON(response)
 	}
 }
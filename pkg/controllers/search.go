@@ -7,6 +7,7 @@ import (
 	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
 	requestDto "github.com/sh5080/ndns-go/pkg/types/dtos/requests"
 	responseDto "github.com/sh5080/ndns-go/pkg/types/dtos/responses"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
 	"github.com/sh5080/ndns-go/pkg/utils"
 )
 
@@ -38,8 +39,14 @@ func Search(searchService _interface.SearchService, postService _interface.PostS
 			}
 		}
 
+		source := req.Source
+		if source == "" {
+			source = string(structure.SearchSourceBlog)
+		}
+
 		response := responseDto.Search{
 			Keyword:          req.Query,
+			Source:           source,
 			TotalResults:     totalResults,
 			SponsoredResults: SponsoredResults,
 			Page:             offset/limit + 1,
@@ -0,0 +1,12 @@
+package _interface
+
+import structure "github.com/sh5080/ndns-go/pkg/types/structures"
+
+// AnalysisRepository는 포스트 분석 결과를 PostID 기준으로 저장하는 인터페이스입니다
+type AnalysisRepository interface {
+	// GetAnalysis는 PostID에 대한 분석 결과를 가져옵니다
+	GetAnalysis(postID structure.PostID) (*structure.BlogPost, error)
+
+	// SaveAnalysis는 포스트의 분석 결과를 PostID 기준으로 저장합니다
+	SaveAnalysis(post structure.BlogPost) error
+}
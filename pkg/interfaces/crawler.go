@@ -0,0 +1,37 @@
+package _interface
+
+import (
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// PlatformAdapter는 블로그 플랫폼별 크롤링 방식을 정의하는 인터페이스입니다
+type PlatformAdapter interface {
+	// Name은 플랫폼 이름을 반환합니다
+	Name() string
+
+	// Match는 URL이 해당 플랫폼에서 처리 가능한지 확인합니다
+	Match(url string) bool
+
+	// Fetch는 URL에서 실제 본문이 담긴 HTML 문서를 가져옵니다
+	Fetch(url string) (*goquery.Document, error)
+
+	// Parse는 HTML 문서에서 콘텐츠를 추출하여 CrawlResult에 채웁니다
+	// is2025OrLater가 true일 경우 마지막 데이터는 가져오지 않습니다.
+	Parse(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool)
+}
+
+// DocumentMatcher는 URL만으로 플랫폼을 판별할 수 없을 때 문서 마크업으로 판별하는 인터페이스입니다
+// 커스텀 도메인을 사용하는 플랫폼 어댑터가 선택적으로 구현합니다
+type DocumentMatcher interface {
+	// MatchDocument는 HTML 문서가 해당 플랫폼의 마크업인지 확인합니다
+	MatchDocument(doc *goquery.Document) bool
+}
+
+// CommentFetcher는 포스트의 댓글 목록을 가져오는 인터페이스입니다
+// 댓글 API를 제공하는 플랫폼 어댑터가 선택적으로 구현합니다
+type CommentFetcher interface {
+	// FetchComments는 본문 문서와 URL로 포스트의 댓글 목록을 가져옵니다
+	FetchComments(doc *goquery.Document, url string) ([]structure.PostComment, error)
+}
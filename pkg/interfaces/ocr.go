@@ -1,19 +1,33 @@
 package _interface
 
-import structure "github.com/sh5080/ndns-go/pkg/types/structures"
+import (
+	"context"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
 
 // OCRService는 이미지에서 텍스트를 추출하는 인터페이스입니다
 type OCRService interface {
 	// ExtractTextFromImage는 이미지 URL에서 텍스트를 추출합니다
-	ExtractTextFromImage(imageURL string) (string, error)
+	// priority는 OCR 대기열에서의 처리 순서입니다 (붐비면 낮은 우선순위부터 거절)
+	ExtractTextFromImage(imageURL string, priority structure.OCRPriority) (string, error)
+}
+
+// OCREngine은 이미지 파일에서 텍스트를 인식하는 OCR 엔진입니다
+type OCREngine interface {
+	// Name은 캐시와 메트릭에 기록하는 엔진 이름입니다
+	Name() string
+
+	// Recognize는 이미지 파일에서 텍스트를 인식합니다 (텍스트가 없으면 빈 문자열)
+	Recognize(ctx context.Context, imagePath string) (string, error)
 }
 
 type OCRRepository interface {
-	// GetOCRCache는 이미지 URL에 대한 OCR 캐시를 가져옵니다
+	// GetOCRCache는 이미지 URL에 대한 OCR 캐시를 가져옵니다 (없거나 만료되었으면 nil)
 	GetOCRCache(imageURL string) (*structure.OCRCache, error)
 
-	// SaveOCRCache는 이미지 URL에 대한 OCR 결과를 저장합니다
-	SaveOCRCache(imageURL string, textDetected string, imageType string) error
+	// SaveOCRCache는 이미지 URL에 대한 OCR 결과를 ExpiresAt까지 저장합니다
+	SaveOCRCache(cache structure.OCRCache) error
 }
 
 type OCRFunc func(imageURL string) (string, error)
@@ -20,5 +20,6 @@ type PostService interface {
 // CrawlerService는 블로그 콘텐츠를 크롤링하는 인터페이스입니다
 type CrawlerService interface {
 	// CrawlBlogPost는 블로그 포스트 URL에서 콘텐츠를 크롤링합니다
-	CrawlBlogPost(url string) (*structure.CrawlResult, error)
+	// is2025OrLater가 true일 경우 마지막 데이터는 가져오지 않습니다.
+	CrawlBlogPost(url string, is2025OrLater bool) (*structure.CrawlResult, error)
 }
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+
+	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// analysisEntry는 저장된 분석 결과와 저장 시간을 보관합니다
+type analysisEntry struct {
+	key     string
+	post    structure.BlogPost
+	savedAt time.Time
+}
+
+// InMemoryAnalysisImpl는 인메모리 분석 결과 저장소 구현체입니다
+// 최대 개수를 넘으면 오래 사용하지 않은 결과부터 삭제하는 LRU 캐시입니다
+type InMemoryAnalysisImpl struct {
+	// 분석 결과 맵 (PostID 키 -> LRU 목록 항목)
+	analyses     map[string]*list.Element
+	lru          *list.List // 앞쪽이 최근 사용한 항목
+	maxEntries   int
+	analysesLock sync.Mutex
+}
+
+// NewAnalysisRepository는 최대 maxEntries개를 보관하는 분석 결과 저장소를 생성합니다
+func NewAnalysisRepository(maxEntries int) _interface.AnalysisRepository {
+	if maxEntries <= 0 {
+		maxEntries = 1
+	}
+
+	return &InMemoryAnalysisImpl{
+		analyses:   make(map[string]*list.Element),
+		lru:        list.New(),
+		maxEntries: maxEntries,
+	}
+}
+
+// GetAnalysis는 PostID에 대한 분석 결과를 가져옵니다
+func (db *InMemoryAnalysisImpl) GetAnalysis(postID structure.PostID) (*structure.BlogPost, error) {
+	if postID.IsZero() {
+		return nil, fmt.Errorf("PostID가 비어 있습니다")
+	}
+
+	db.analysesLock.Lock()
+	defer db.analysesLock.Unlock()
+
+	key := postID.Key()
+	element, exists := db.analyses[key]
+	if !exists {
+		return nil, nil // 저장된 결과 없음 (에러 아님)
+	}
+
+	// 만료 확인
+	entry := element.Value.(*analysisEntry)
+	if time.Since(entry.savedAt) > constants.ANALYSIS_CACHE_TTL {
+		db.lru.Remove(element)
+		delete(db.analyses, key)
+		return nil, nil // 만료된 결과
+	}
+
+	db.lru.MoveToFront(element)
+	post := entry.post
+	return &post, nil
+}
+
+// SaveAnalysis는 포스트의 분석 결과를 PostID 기준으로 저장합니다
+// 최대 개수를 넘으면 오래 사용하지 않은 결과를 삭제합니다
+func (db *InMemoryAnalysisImpl) SaveAnalysis(post structure.BlogPost) error {
+	if post.PostID == nil || post.PostID.IsZero() {
+		return fmt.Errorf("PostID가 없는 포스트는 저장할 수 없습니다")
+	}
+
+	db.analysesLock.Lock()
+	defer db.analysesLock.Unlock()
+
+	entry := &analysisEntry{
+		key:     post.PostID.Key(),
+		post:    post,
+		savedAt: time.Now(),
+	}
+	if element, exists := db.analyses[entry.key]; exists {
+		element.Value = entry
+		db.lru.MoveToFront(element)
+		return nil
+	}
+	db.analyses[entry.key] = db.lru.PushFront(entry)
+
+	for db.lru.Len() > db.maxEntries {
+		oldest := db.lru.Back()
+		db.lru.Remove(oldest)
+		delete(db.analyses, oldest.Value.(*analysisEntry).key)
+	}
+
+	return nil
+}
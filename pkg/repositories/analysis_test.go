@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// analyzedPost는 postNo 포스트의 분석 결과를 만듭니다
+func analyzedPost(postNo string) structure.BlogPost {
+	return structure.BlogPost{PostID: &structure.PostID{Platform: structure.PlatformNaverBlog, Owner: "tester", PostNo: postNo}}
+}
+
+func TestAnalysisRepositoryEvictsLeastRecentlyUsed(t *testing.T) {
+	repo := NewAnalysisRepository(2)
+	for _, postNo := range []string{"1", "2"} {
+		if err := repo.SaveAnalysis(analyzedPost(postNo)); err != nil {
+			t.Fatalf("SaveAnalysis 실패: %v", err)
+		}
+	}
+	repo.GetAnalysis(*analyzedPost("1").PostID) // 1을 최근 사용으로 갱신
+	repo.SaveAnalysis(analyzedPost("3"))
+
+	for postNo, want := range map[string]bool{"1": true, "2": false, "3": true} {
+		if post, _ := repo.GetAnalysis(*analyzedPost(postNo).PostID); (post != nil) != want {
+			t.Errorf("GetAnalysis(%s) 존재 = %v, want %v", postNo, post != nil, want)
+		}
+	}
+}
+
+func TestAnalysisRepositoryDeletesExpired(t *testing.T) {
+	repo := NewAnalysisRepository(2)
+	post := analyzedPost("1")
+	repo.SaveAnalysis(post)
+
+	// 저장 시간을 보관 시간 이전으로 옮김
+	impl := repo.(*InMemoryAnalysisImpl)
+	impl.analyses[post.PostID.Key()].Value.(*analysisEntry).savedAt = time.Now().Add(-constants.ANALYSIS_CACHE_TTL - time.Minute)
+
+	if cached, _ := repo.GetAnalysis(*post.PostID); cached != nil {
+		t.Fatalf("만료된 결과가 반환되었습니다")
+	}
+	if len(impl.analyses) != 0 || impl.lru.Len() != 0 {
+		t.Errorf("만료된 결과가 삭제되지 않았습니다 (맵 %d개, 목록 %d개)", len(impl.analyses), impl.lru.Len())
+	}
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"container/list"
 	"fmt"
 	"sync"
 	"time"
@@ -9,17 +10,26 @@ import (
 	structure "github.com/sh5080/ndns-go/pkg/types/structures"
 )
 
-// InMemoryDBImpl는 인메모리 데이터베이스 구현체입니다
+// InMemoryDBImpl는 인메모리 OCR 캐시 저장소 구현체입니다
+// 최대 개수를 넘으면 오래 사용하지 않은 항목부터 삭제하는 LRU 캐시입니다
 type InMemoryDBImpl struct {
-	// OCR 캐시 맵 (URL -> 결과)
-	ocrCache     map[string]*structure.OCRCache
-	ocrCacheLock sync.RWMutex
+	// OCR 캐시 맵 (URL -> LRU 목록 항목)
+	ocrCache     map[string]*list.Element
+	lru          *list.List // 앞쪽이 최근 사용한 항목
+	maxEntries   int
+	ocrCacheLock sync.Mutex
 }
 
-// NewOCRRepository는 새 OCR 저장소를 생성합니다
-func NewOCRRepository() _interface.OCRRepository {
+// NewMemoryOCRRepository는 최대 maxEntries개를 보관하는 인메모리 OCR 저장소를 생성합니다
+func NewMemoryOCRRepository(maxEntries int) _interface.OCRRepository {
+	if maxEntries <= 0 {
+		maxEntries = 1
+	}
+
 	return &InMemoryDBImpl{
-		ocrCache: make(map[string]*structure.OCRCache),
+		ocrCache:   make(map[string]*list.Element),
+		lru:        list.New(),
+		maxEntries: maxEntries,
 	}
 }
 
@@ -29,45 +39,61 @@ func (db *InMemoryDBImpl) GetOCRCache(imageURL string) (*structure.OCRCache, err
 		return nil, fmt.Errorf("이미지 URL이 비어 있습니다")
 	}
 
-	// 읽기 잠금 획득
-	db.ocrCacheLock.RLock()
-	defer db.ocrCacheLock.RUnlock()
+	db.ocrCacheLock.Lock()
+	defer db.ocrCacheLock.Unlock()
 
 	// 캐시 확인
-	cache, exists := db.ocrCache[imageURL]
+	element, exists := db.ocrCache[imageURL]
 	if !exists {
 		return nil, nil // 캐시 없음 (에러 아님)
 	}
 
-	// 캐시 만료 확인 (24시간)
-	if time.Since(cache.DetectedAt) > 24*time.Hour {
+	// 캐시 만료 확인
+	cache := *element.Value.(*structure.OCRCache)
+	if cache.IsExpired(time.Now()) {
+		db.lru.Remove(element)
+		delete(db.ocrCache, imageURL)
 		return nil, nil // 만료된 캐시
 	}
 
-	return cache, nil
+	db.lru.MoveToFront(element)
+	return &cache, nil
 }
 
 // SaveOCRCache는 이미지 URL에 대한 OCR 결과를 저장합니다
-func (db *InMemoryDBImpl) SaveOCRCache(imageURL string, textDetected string, imageType string) error {
-	if imageURL == "" {
-		return fmt.Errorf("이미지 URL이 비어 있습니다")
-	}
-
-	if textDetected == "" {
-		return fmt.Errorf("OCR 텍스트가 비어 있습니다")
+// 최대 개수를 넘으면 오래 사용하지 않은 항목을 삭제합니다
+func (db *InMemoryDBImpl) SaveOCRCache(cache structure.OCRCache) error {
+	if err := validateOCRCache(cache); err != nil {
+		return err
 	}
 
-	// 쓰기 잠금 획득
 	db.ocrCacheLock.Lock()
 	defer db.ocrCacheLock.Unlock()
 
 	// 캐시 저장
-	db.ocrCache[imageURL] = &structure.OCRCache{
-		ImageURL:     imageURL,
-		TextDetected: textDetected,
-		ImageType:    imageType,
-		DetectedAt:   time.Now(),
+	if element, exists := db.ocrCache[cache.ImageURL]; exists {
+		element.Value = &cache
+		db.lru.MoveToFront(element)
+		return nil
 	}
+	db.ocrCache[cache.ImageURL] = db.lru.PushFront(&cache)
 
+	for db.lru.Len() > db.maxEntries {
+		oldest := db.lru.Back()
+		db.lru.Remove(oldest)
+		delete(db.ocrCache, oldest.Value.(*structure.OCRCache).ImageURL)
+	}
+
+	return nil
+}
+
+// validateOCRCache는 저장할 수 있는 OCR 캐시인지 확인합니다 (모든 저장소 공통)
+func validateOCRCache(cache structure.OCRCache) error {
+	if cache.ImageURL == "" {
+		return fmt.Errorf("이미지 URL이 비어 있습니다")
+	}
+	if cache.TextDetected == "" && !cache.IsNegative() {
+		return fmt.Errorf("OCR 텍스트가 비어 있습니다")
+	}
 	return nil
 }
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/sh5080/ndns-go/pkg/configs"
+	"github.com/sh5080/ndns-go/pkg/db"
+	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+)
+
+// OCRCacheBackend는 OCR 결과 저장소 종류입니다
+type OCRCacheBackend string
+
+const (
+	OCRCacheBackendMemory   OCRCacheBackend = "memory"   // 프로세스 메모리 LRU (재시작하면 사라짐)
+	OCRCacheBackendBolt     OCRCacheBackend = "bolt"     // bbolt 파일 (단일 인스턴스)
+	OCRCacheBackendDynamoDB OCRCacheBackend = "dynamodb" // AWS DynamoDB
+	OCRCacheBackendRedis    OCRCacheBackend = "redis"    // Redis
+)
+
+// NewOCRRepository는 설정에 따라 OCR 저장소를 생성합니다
+// 메모리 외의 저장소를 사용하면 프로세스 메모리 LRU를 먼저 조회하고, 저장소를 준비하지 못하면 메모리 LRU만 사용합니다
+func NewOCRRepository(config *configs.EnvConfig) _interface.OCRRepository {
+	memory := OCRCacheTier{Name: string(OCRCacheBackendMemory), Repo: NewMemoryOCRRepository(config.OCR.CacheMaxEntries)}
+
+	backend := OCRCacheBackend(config.OCR.CacheBackend)
+	if backend == "" {
+		backend = OCRCacheBackendMemory
+		if config.AWS.Tables.OCRCache != "" {
+			backend = OCRCacheBackendDynamoDB
+		}
+	}
+	if backend == OCRCacheBackendMemory {
+		return NewTieredOCRRepository(memory)
+	}
+
+	repo, err := newOCRBackend(backend, config)
+	if err != nil {
+		fmt.Printf("OCR 캐시 저장소 초기화 실패 (메모리 캐시만 사용): %v\n", err)
+		return NewTieredOCRRepository(memory)
+	}
+	fmt.Printf("OCR 캐시 저장소: %s\n", backend)
+	return NewTieredOCRRepository(memory, OCRCacheTier{Name: string(backend), Repo: repo})
+}
+
+// newOCRBackend는 메모리 LRU 뒤에 두는 OCR 저장소를 생성합니다
+func newOCRBackend(backend OCRCacheBackend, config *configs.EnvConfig) (_interface.OCRRepository, error) {
+	switch backend {
+	case OCRCacheBackendBolt:
+		return NewBoltOCRRepository(config.OCR.CacheFile)
+	case OCRCacheBackendDynamoDB:
+		if config.AWS.Tables.OCRCache == "" {
+			return nil, fmt.Errorf("DynamoDB OCR 캐시 테이블이 설정되지 않았습니다")
+		}
+		service, err := db.NewDynamoDBService(config)
+		if err != nil {
+			return nil, err
+		}
+		if err := service.CreateTableIfNotExists(); err != nil {
+			return nil, err
+		}
+		return NewDynamoOCRRepository(service), nil
+	case OCRCacheBackendRedis:
+		if config.OCR.CacheRedisURL == "" {
+			return nil, fmt.Errorf("Redis OCR 캐시 주소가 설정되지 않았습니다")
+		}
+		return NewRedisOCRRepository(config.OCR.CacheRedisURL)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 OCR 캐시 저장소입니다: %q", backend)
+	}
+}
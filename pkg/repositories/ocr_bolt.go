@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// OCR 캐시를 저장하는 bbolt 버킷
+var ocrBoltBucket = []byte("ocr_cache")
+
+// BoltOCRImpl는 bbolt 파일에 OCR 캐시를 저장하는 내장 저장소 구현체입니다
+// 외부 데이터베이스 없이 재시작 후에도 결과를 유지합니다 (VM, 단일 인스턴스 배포용)
+// 만료된 항목은 파일을 열 때와, 저장할 때 OCR_BOLT_PURGE_INTERVAL이 지났으면 정리합니다
+type BoltOCRImpl struct {
+	db        *bolt.DB
+	lastPurge time.Time // 마지막으로 만료된 항목을 정리한 시각 (쓰기 트랜잭션 안에서만 사용)
+}
+
+// NewBoltOCRRepository는 path의 bbolt 파일을 열어 OCR 저장소를 생성합니다
+// 파일이 없으면 새로 만들고, 열 때 만료된 항목을 정리합니다
+func NewBoltOCRRepository(path string) (*BoltOCRImpl, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("OCR 캐시 디렉토리 생성 실패: %v", err)
+	}
+
+	// 다른 프로세스가 파일을 사용 중이면 기다리지 않고 실패
+	db, err := bolt.Open(path, 0o644, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("OCR 캐시 파일 열기 실패: %v", err)
+	}
+
+	repo := &BoltOCRImpl{db: db}
+	if err := repo.prepare(time.Now()); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return repo, nil
+}
+
+// GetOCRCache는 이미지 URL에 대한 OCR 캐시를 가져옵니다
+func (r *BoltOCRImpl) GetOCRCache(imageURL string) (*structure.OCRCache, error) {
+	if imageURL == "" {
+		return nil, fmt.Errorf("이미지 URL이 비어 있습니다")
+	}
+
+	var cache *structure.OCRCache
+	err := r.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(ocrBoltBucket).Get([]byte(imageURL))
+		if data == nil {
+			return nil
+		}
+
+		var stored structure.OCRCache
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return fmt.Errorf("OCR 캐시 파싱 실패: %v", err)
+		}
+		cache = &stored
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 만료된 캐시 (정리 간격이 지난 뒤의 저장 또는 재시작 때 정리)
+	if cache == nil || cache.IsExpired(time.Now()) {
+		return nil, nil
+	}
+	return cache, nil
+}
+
+// SaveOCRCache는 이미지 URL에 대한 OCR 결과를 저장합니다
+// 마지막 정리 후 OCR_BOLT_PURGE_INTERVAL이 지났으면 같은 트랜잭션에서 만료된 항목을 정리합니다
+func (r *BoltOCRImpl) SaveOCRCache(cache structure.OCRCache) error {
+	if err := validateOCRCache(cache); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return fmt.Errorf("OCR 캐시 직렬화 실패: %v", err)
+	}
+
+	// bolt 쓰기 트랜잭션은 한 번에 하나만 실행되므로 lastPurge를 잠금 없이 사용
+	now := time.Now()
+	err = r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(ocrBoltBucket)
+		if err := bucket.Put([]byte(cache.ImageURL), data); err != nil {
+			return err
+		}
+		if now.Sub(r.lastPurge) < constants.OCR_BOLT_PURGE_INTERVAL {
+			return nil
+		}
+		return r.purgeExpired(bucket, now)
+	})
+	if err != nil {
+		return fmt.Errorf("OCR 캐시 저장 실패: %v", err)
+	}
+	return nil
+}
+
+// Close는 bbolt 파일을 닫습니다
+func (r *BoltOCRImpl) Close() error {
+	return r.db.Close()
+}
+
+// prepare는 버킷을 준비하고 만료된 항목을 정리합니다
+func (r *BoltOCRImpl) prepare(now time.Time) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(ocrBoltBucket)
+		if err != nil {
+			return err
+		}
+		return r.purgeExpired(bucket, now)
+	})
+	if err != nil {
+		return fmt.Errorf("OCR 캐시 파일 정리 실패: %v", err)
+	}
+	return nil
+}
+
+// purgeExpired는 만료되었거나 읽을 수 없는 항목을 삭제합니다 (쓰기 트랜잭션 안에서 호출)
+func (r *BoltOCRImpl) purgeExpired(bucket *bolt.Bucket, now time.Time) error {
+	// 순회 중에는 삭제할 수 없으므로 키를 모은 뒤 삭제
+	var expired [][]byte
+	err := bucket.ForEach(func(key, data []byte) error {
+		var stored structure.OCRCache
+		if json.Unmarshal(data, &stored) != nil || stored.IsExpired(now) {
+			expired = append(expired, append([]byte(nil), key...))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+	}
+	r.lastPurge = now
+	return nil
+}
@@ -0,0 +1,314 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/sh5080/ndns-go/pkg/configs"
+	"github.com/sh5080/ndns-go/pkg/db"
+	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// ocrBackendFactories는 적합성 테스트를 실행할 저장소 목록입니다 (모두 로컬 대체 서버 사용)
+var ocrBackendFactories = map[string]func(t *testing.T) _interface.OCRRepository{
+	"memory": func(t *testing.T) _interface.OCRRepository {
+		return NewMemoryOCRRepository(100)
+	},
+	"bolt": func(t *testing.T) _interface.OCRRepository {
+		repo, err := NewBoltOCRRepository(filepath.Join(t.TempDir(), "ocr", "cache.db"))
+		if err != nil {
+			t.Fatalf("bolt 저장소 생성 실패: %v", err)
+		}
+		t.Cleanup(func() { repo.Close() })
+		return repo
+	},
+	"redis": func(t *testing.T) _interface.OCRRepository {
+		server := miniredis.RunT(t)
+		repo, err := NewRedisOCRRepository("redis://" + server.Addr() + "/0")
+		if err != nil {
+			t.Fatalf("redis 저장소 생성 실패: %v", err)
+		}
+		t.Cleanup(func() { repo.Close() })
+		return repo
+	},
+	"dynamodb": func(t *testing.T) _interface.OCRRepository {
+		server := httptest.NewServer(newFakeDynamoDB())
+		t.Cleanup(server.Close)
+
+		config := &configs.EnvConfig{}
+		config.AWS.AccessKeyID = "test"
+		config.AWS.SecretAccessKey = "test"
+		config.AWS.Region = "ap-northeast-2"
+		config.AWS.DynamoDBEndpoint = server.URL
+		config.AWS.Tables.OCRCache = "ocr-cache"
+
+		service, err := db.NewDynamoDBService(config)
+		if err != nil {
+			t.Fatalf("DynamoDB 서비스 생성 실패: %v", err)
+		}
+		if err := service.CreateTableIfNotExists(); err != nil {
+			t.Fatalf("DynamoDB 테이블 준비 실패: %v", err)
+		}
+		return NewDynamoOCRRepository(service)
+	},
+	"tiered": func(t *testing.T) _interface.OCRRepository {
+		return NewTieredOCRRepository(
+			OCRCacheTier{Name: "memory", Repo: NewMemoryOCRRepository(100)},
+			OCRCacheTier{Name: "memory_2", Repo: NewMemoryOCRRepository(100)},
+		)
+	},
+}
+
+func TestOCRRepositoryConformance(t *testing.T) {
+	for name, factory := range ocrBackendFactories {
+		t.Run(name, func(t *testing.T) {
+			testOCRRepository(t, factory(t))
+		})
+	}
+}
+
+// testOCRRepository는 모든 OCR 저장소가 지켜야 하는 동작을 확인합니다
+func testOCRRepository(t *testing.T, repo _interface.OCRRepository) {
+	now := time.Now()
+	imageURL := "https://postfiles.pstatic.net/MjAyNDAz/협찬_image.jpg?type=w773"
+
+	// 저장된 결과가 없으면 nil (에러 아님)
+	if cache, err := repo.GetOCRCache(imageURL); cache != nil || err != nil {
+		t.Fatalf("빈 저장소 조회 = %+v, %v, want nil, nil", cache, err)
+	}
+
+	// 잘못된 항목은 저장하지 않음
+	if _, err := repo.GetOCRCache(""); err == nil {
+		t.Errorf("빈 URL 조회에서 에러가 반환되지 않았습니다")
+	}
+	for _, invalid := range []structure.OCRCache{
+		{TextDetected: "협찬", ExpiresAt: now.Add(time.Hour)},
+		{ImageURL: imageURL, ExpiresAt: now.Add(time.Hour)},
+	} {
+		if err := repo.SaveOCRCache(invalid); err == nil {
+			t.Errorf("SaveOCRCache(%+v) 에러가 반환되지 않았습니다", invalid)
+		}
+	}
+
+	// 저장한 결과를 그대로 조회
+	saved := structure.OCRCache{
+		ImageURL:     imageURL,
+		TextDetected: "이 포스팅은 업체로부터 제품을 제공받아 작성되었습니다",
+		ImageType:    "sticker",
+		Engine:       "tesseract",
+		DetectedAt:   now,
+		ExpiresAt:    now.Add(time.Hour),
+	}
+	if err := repo.SaveOCRCache(saved); err != nil {
+		t.Fatalf("SaveOCRCache 실패: %v", err)
+	}
+	assertOCRCache(t, repo, saved)
+
+	// 같은 URL에 다시 저장하면 덮어씀 (부정 캐시)
+	negative := structure.OCRCache{
+		ImageURL:   imageURL,
+		ErrorCode:  structure.ErrorCodeOCREmpty,
+		DetectedAt: now,
+		ExpiresAt:  now.Add(30 * time.Minute),
+	}
+	if err := repo.SaveOCRCache(negative); err != nil {
+		t.Fatalf("부정 캐시 SaveOCRCache 실패: %v", err)
+	}
+	cache := assertOCRCache(t, repo, negative)
+	if !errors.Is(cache.Err(), structure.ErrOCREmpty) {
+		t.Errorf("부정 캐시 Err() = %v, want ErrOCREmpty", cache.Err())
+	}
+
+	// 만료된 결과는 조회되지 않음
+	expiredURL := imageURL + "&expired=1"
+	expired := structure.OCRCache{ImageURL: expiredURL, TextDetected: "협찬", DetectedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour)}
+	if err := repo.SaveOCRCache(expired); err != nil {
+		t.Fatalf("만료된 항목 SaveOCRCache 실패: %v", err)
+	}
+	if cache, err := repo.GetOCRCache(expiredURL); cache != nil || err != nil {
+		t.Errorf("만료된 항목 조회 = %+v, %v, want nil, nil", cache, err)
+	}
+
+	// 여러 요청이 동시에 사용해도 안전
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			url := fmt.Sprintf("https://example.com/%d.png", i)
+			if err := repo.SaveOCRCache(structure.OCRCache{ImageURL: url, TextDetected: url, DetectedAt: now, ExpiresAt: now.Add(time.Hour)}); err != nil {
+				t.Errorf("동시 SaveOCRCache 실패: %v", err)
+				return
+			}
+			if cache, err := repo.GetOCRCache(url); err != nil || cache == nil || cache.TextDetected != url {
+				t.Errorf("동시 GetOCRCache(%s) = %+v, %v", url, cache, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// assertOCRCache는 저장소에서 조회한 결과가 want와 같은지 확인합니다
+func assertOCRCache(t *testing.T, repo _interface.OCRRepository, want structure.OCRCache) *structure.OCRCache {
+	t.Helper()
+
+	got, err := repo.GetOCRCache(want.ImageURL)
+	if err != nil || got == nil {
+		t.Fatalf("GetOCRCache = %+v, %v, want %+v", got, err, want)
+	}
+	if got.ImageURL != want.ImageURL || got.TextDetected != want.TextDetected || got.ErrorCode != want.ErrorCode || got.Engine != want.Engine {
+		t.Errorf("GetOCRCache = %+v, want %+v", got, want)
+	}
+	if !got.ExpiresAt.Truncate(time.Second).Equal(want.ExpiresAt.Truncate(time.Second)) {
+		t.Errorf("ExpiresAt = %v, want %v", got.ExpiresAt, want.ExpiresAt)
+	}
+	return got
+}
+
+func TestTieredOCRRepositoryBackfill(t *testing.T) {
+	front := NewMemoryOCRRepository(10)
+	back := NewMemoryOCRRepository(10)
+	repo := NewTieredOCRRepository(OCRCacheTier{Name: "front", Repo: front}, OCRCacheTier{Name: "back", Repo: back})
+
+	now := time.Now()
+	cache := structure.OCRCache{ImageURL: "https://example.com/a.png", TextDetected: "협찬", DetectedAt: now, ExpiresAt: now.Add(time.Hour)}
+	if err := back.SaveOCRCache(cache); err != nil {
+		t.Fatalf("SaveOCRCache 실패: %v", err)
+	}
+
+	// 뒤쪽 단계에서 찾은 결과는 앞쪽 단계에 채워 넣음
+	if got, err := repo.GetOCRCache(cache.ImageURL); err != nil || got == nil {
+		t.Fatalf("GetOCRCache = %+v, %v", got, err)
+	}
+	if got, _ := front.GetOCRCache(cache.ImageURL); got == nil || got.TextDetected != cache.TextDetected {
+		t.Errorf("앞쪽 단계 = %+v, want 채워 넣은 결과", got)
+	}
+}
+
+func TestBoltOCRRepositoryPurgesExpiredOnSave(t *testing.T) {
+	repo, err := NewBoltOCRRepository(filepath.Join(t.TempDir(), "cache.db"))
+	if err != nil {
+		t.Fatalf("bolt 저장소 생성 실패: %v", err)
+	}
+	defer repo.Close()
+
+	now := time.Now()
+	save := func(url string, expiresAt time.Time) {
+		if err := repo.SaveOCRCache(structure.OCRCache{ImageURL: url, TextDetected: url, ExpiresAt: expiresAt}); err != nil {
+			t.Fatalf("SaveOCRCache 실패: %v", err)
+		}
+	}
+	stored := func(url string) bool {
+		var found bool
+		repo.db.View(func(tx *bolt.Tx) error {
+			found = tx.Bucket(ocrBoltBucket).Get([]byte(url)) != nil
+			return nil
+		})
+		return found
+	}
+
+	// 정리 간격 안에서는 만료된 항목도 파일에 남아 있음 (조회되지는 않음)
+	save("expired", now.Add(-time.Minute))
+	save("fresh", now.Add(time.Hour))
+	if !stored("expired") {
+		t.Fatal("정리 간격이 지나기 전에 만료된 항목이 삭제되었습니다")
+	}
+
+	// 정리 간격이 지난 뒤 저장하면 만료된 항목을 삭제
+	repo.lastPurge = now.Add(-constants.OCR_BOLT_PURGE_INTERVAL)
+	save("next", now.Add(time.Hour))
+	for url, want := range map[string]bool{"expired": false, "fresh": true, "next": true} {
+		if got := stored(url); got != want {
+			t.Errorf("%s 항목 저장 여부 = %v, want %v", url, got, want)
+		}
+	}
+}
+
+func TestMemoryOCRRepositoryEvictsLeastRecentlyUsed(t *testing.T) {
+	repo := NewMemoryOCRRepository(2)
+	now := time.Now()
+	save := func(url string) {
+		if err := repo.SaveOCRCache(structure.OCRCache{ImageURL: url, TextDetected: url, ExpiresAt: now.Add(time.Hour)}); err != nil {
+			t.Fatalf("SaveOCRCache 실패: %v", err)
+		}
+	}
+
+	save("a")
+	save("b")
+	repo.GetOCRCache("a") // a를 최근 사용으로 갱신
+	save("c")
+
+	for url, want := range map[string]bool{"a": true, "b": false, "c": true} {
+		if cache, _ := repo.GetOCRCache(url); (cache != nil) != want {
+			t.Errorf("GetOCRCache(%s) 존재 = %v, want %v", url, cache != nil, want)
+		}
+	}
+}
+
+// fakeDynamoDB는 OCR 캐시 테이블에 필요한 DynamoDB API(DescribeTable, GetItem, PutItem, DeleteItem)만 흉내 내는 로컬 서버입니다
+type fakeDynamoDB struct {
+	items map[string]json.RawMessage // ImageURL → 항목
+	lock  sync.Mutex
+}
+
+func newFakeDynamoDB() *fakeDynamoDB {
+	return &fakeDynamoDB{items: make(map[string]json.RawMessage)}
+}
+
+// dynamoKey는 요청의 ImageURL 키 값을 꺼냅니다
+type dynamoKey struct {
+	ImageURL struct {
+		S string `json:"S"`
+	} `json:"ImageURL"`
+}
+
+func (f *fakeDynamoDB) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		TableName string          `json:"TableName"`
+		Key       dynamoKey       `json:"Key"`
+		Item      json.RawMessage `json:"Item"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	response := map[string]any{}
+	switch operation := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "DynamoDB_20120810."); operation {
+	case "DescribeTable":
+		response["Table"] = map[string]any{"TableName": request.TableName, "TableStatus": "ACTIVE"}
+	case "GetItem":
+		if item, exists := f.items[request.Key.ImageURL.S]; exists {
+			response["Item"] = item
+		}
+	case "PutItem":
+		var key dynamoKey
+		json.Unmarshal(request.Item, &key)
+		f.items[key.ImageURL.S] = request.Item
+	case "DeleteItem":
+		delete(f.items, request.Key.ImageURL.S)
+	default:
+		http.Error(w, "unsupported operation: "+operation, http.StatusBadRequest)
+		return
+	}
+
+	body, _ := json.Marshal(response)
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.Write(body)
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/sh5080/ndns-go/pkg/db"
+	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+	model "github.com/sh5080/ndns-go/pkg/types/models"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// DynamoOCRImpl는 DynamoDB OCR 캐시 저장소 구현체입니다
+type DynamoOCRImpl struct {
+	service *db.DynamoDBService
+}
+
+// NewDynamoOCRRepository는 DynamoDB 서비스를 사용하는 OCR 저장소를 생성합니다
+func NewDynamoOCRRepository(service *db.DynamoDBService) _interface.OCRRepository {
+	return &DynamoOCRImpl{service: service}
+}
+
+// GetOCRCache는 이미지 URL에 대한 OCR 캐시를 가져옵니다
+func (r *DynamoOCRImpl) GetOCRCache(imageURL string) (*structure.OCRCache, error) {
+	if imageURL == "" {
+		return nil, fmt.Errorf("이미지 URL이 비어 있습니다")
+	}
+
+	item, err := r.service.GetOCRCache(imageURL)
+	if err != nil || item == nil {
+		return nil, err
+	}
+
+	return &structure.OCRCache{
+		ImageURL:     item.ImageURL,
+		TextDetected: item.TextDetected,
+		ImageType:    string(item.ImageType),
+		ErrorCode:    structure.ErrorCode(item.ErrorCode),
+		Engine:       item.Engine,
+		DetectedAt:   item.CreatedAt,
+		ExpiresAt:    item.ExpiresAt,
+	}, nil
+}
+
+// SaveOCRCache는 이미지 URL에 대한 OCR 결과를 저장합니다
+func (r *DynamoOCRImpl) SaveOCRCache(cache structure.OCRCache) error {
+	if err := validateOCRCache(cache); err != nil {
+		return err
+	}
+
+	return r.service.SaveOCRCache(&model.OCRCache{
+		ImageURL:     cache.ImageURL,
+		TextDetected: cache.TextDetected,
+		ImageType:    structure.BlogImage(cache.ImageType),
+		ErrorCode:    string(cache.ErrorCode),
+		Engine:       cache.Engine,
+		ExpiresAt:    cache.ExpiresAt,
+	})
+}
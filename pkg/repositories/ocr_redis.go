@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// RedisOCRImpl는 Redis에 OCR 캐시를 저장하는 저장소 구현체입니다
+// 항목마다 만료 시간을 설정하므로 Redis가 만료된 항목을 정리합니다
+type RedisOCRImpl struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisOCRRepository는 redisURL(redis://host:6379/0)의 Redis에 연결하여 OCR 저장소를 생성합니다
+func NewRedisOCRRepository(redisURL string) (*RedisOCRImpl, error) {
+	options, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("Redis 주소 해석 실패: %v", err)
+	}
+
+	client := redis.NewClient(options)
+	ctx, cancel := context.WithTimeout(context.Background(), constants.TIMEOUT)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("Redis 연결 실패: %v", err)
+	}
+
+	return &RedisOCRImpl{
+		client: client,
+		prefix: constants.OCR_CACHE_REDIS_KEY_PREFIX,
+	}, nil
+}
+
+// GetOCRCache는 이미지 URL에 대한 OCR 캐시를 가져옵니다
+func (r *RedisOCRImpl) GetOCRCache(imageURL string) (*structure.OCRCache, error) {
+	if imageURL == "" {
+		return nil, fmt.Errorf("이미지 URL이 비어 있습니다")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.TIMEOUT)
+	defer cancel()
+
+	data, err := r.client.Get(ctx, r.prefix+imageURL).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil // 캐시 없음 (에러 아님)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("OCR 캐시 조회 실패: %v", err)
+	}
+
+	var cache structure.OCRCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("OCR 캐시 파싱 실패: %v", err)
+	}
+	if cache.IsExpired(time.Now()) {
+		return nil, nil
+	}
+	return &cache, nil
+}
+
+// SaveOCRCache는 이미지 URL에 대한 OCR 결과를 ExpiresAt에 만료되도록 저장합니다
+// 이미 만료된 결과는 저장하지 않습니다
+func (r *RedisOCRImpl) SaveOCRCache(cache structure.OCRCache) error {
+	if err := validateOCRCache(cache); err != nil {
+		return err
+	}
+
+	var ttl time.Duration
+	if !cache.ExpiresAt.IsZero() {
+		ttl = time.Until(cache.ExpiresAt)
+		if ttl <= 0 {
+			return nil
+		}
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return fmt.Errorf("OCR 캐시 직렬화 실패: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.TIMEOUT)
+	defer cancel()
+
+	if err := r.client.Set(ctx, r.prefix+cache.ImageURL, data, ttl).Err(); err != nil {
+		return fmt.Errorf("OCR 캐시 저장 실패: %v", err)
+	}
+	return nil
+}
+
+// Close는 Redis 연결을 닫습니다
+func (r *RedisOCRImpl) Close() error {
+	return r.client.Close()
+}
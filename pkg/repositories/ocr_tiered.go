@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+	"github.com/sh5080/ndns-go/pkg/utils"
+)
+
+// OCRCacheTier는 단계별 OCR 캐시의 한 단계입니다
+type OCRCacheTier struct {
+	Name string // 메트릭에 표시하는 이름 (memory, bolt, dynamodb, redis)
+	Repo _interface.OCRRepository
+}
+
+// TieredOCRImpl는 여러 OCR 저장소를 빠른 순서대로 조회하는 단계별 캐시 구현체입니다
+// 뒤쪽 단계에서 찾은 결과는 앞쪽 단계에 채워 넣고, 저장은 모든 단계에 합니다
+type TieredOCRImpl struct {
+	tiers []OCRCacheTier
+}
+
+// NewTieredOCRRepository는 앞쪽 단계부터 조회하는 단계별 OCR 저장소를 생성합니다
+func NewTieredOCRRepository(tiers ...OCRCacheTier) _interface.OCRRepository {
+	return &TieredOCRImpl{tiers: tiers}
+}
+
+// GetOCRCache는 앞쪽 단계부터 OCR 캐시를 조회합니다
+// 조회에 실패한 단계는 건너뛰며, 단계별 조회 결과를 캐시 메트릭에 기록합니다
+func (r *TieredOCRImpl) GetOCRCache(imageURL string) (*structure.OCRCache, error) {
+	if imageURL == "" {
+		return nil, fmt.Errorf("이미지 URL이 비어 있습니다")
+	}
+
+	for i, tier := range r.tiers {
+		cache, err := tier.Repo.GetOCRCache(imageURL)
+		if err != nil {
+			fmt.Printf("OCR 캐시 조회 실패 (%s, 다음 단계로 진행): %v\n", tier.Name, err)
+			utils.RecordCacheResult("ocr_"+tier.Name, "error")
+			continue
+		}
+		if cache == nil {
+			utils.RecordCacheResult("ocr_"+tier.Name, "miss")
+			continue
+		}
+
+		utils.RecordCacheResult("ocr_"+tier.Name, "hit")
+		// 다음 조회부터 앞쪽 단계에서 찾도록 채워 넣음
+		for _, upper := range r.tiers[:i] {
+			if err := upper.Repo.SaveOCRCache(*cache); err != nil {
+				fmt.Printf("OCR 캐시 채우기 실패 (%s): %v\n", upper.Name, err)
+			}
+		}
+		return cache, nil
+	}
+	return nil, nil
+}
+
+// SaveOCRCache는 모든 단계에 OCR 결과를 저장합니다
+// 일부 단계에서 실패해도 나머지 단계에는 저장하며, 실패한 단계를 모아 에러로 반환합니다
+func (r *TieredOCRImpl) SaveOCRCache(cache structure.OCRCache) error {
+	var failures []string
+	for _, tier := range r.tiers {
+		if err := tier.Repo.SaveOCRCache(cache); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", tier.Name, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("OCR 캐시 저장 실패 (%s)", strings.Join(failures, ", "))
+	}
+	return nil
+}
@@ -6,7 +6,6 @@ import (
 	naver "github.com/sh5080/ndns-go/pkg/clients"
 	"github.com/sh5080/ndns-go/pkg/configs"
 	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
-	"github.com/sh5080/ndns-go/pkg/services/internal/detector"
 	request "github.com/sh5080/ndns-go/pkg/types/dtos/requests"
 	structure "github.com/sh5080/ndns-go/pkg/types/structures"
 )
@@ -19,11 +18,9 @@ type SearchImpl struct {
 }
 
 // NewSearchService는 새 검색 서비스를 생성합니다
-func NewSearchService() _interface.SearchService {
+func NewSearchService(postService _interface.PostService) _interface.SearchService {
 	config := configs.GetConfig()
 	naverClient := naver.NewNaverAPIClient(config)
-	ocrService := detector.NewOCRService()
-	postService := detector.NewPostService(ocrService)
 
 	return &SearchImpl{
 		Service:     _interface.Service{Config: config},
@@ -38,10 +35,16 @@ func (s *SearchImpl) SearchBlogPosts(req request.SearchQuery) ([]structure.BlogP
 		return nil, 0, fmt.Errorf("네이버 API 클라이언트가 초기화되지 않았습니다")
 	}
 
-	// 네이버 블로그 검색 API 호출
-	searchResp, err := s.naverClient.SearchBlog(req.Query, req.Limit, req.Offset+1)
+	// 검색 대상이 지정되지 않은 경우 블로그 검색
+	source := structure.SearchSource(req.Source)
+	if source == "" {
+		source = structure.SearchSourceBlog
+	}
+
+	// 네이버 검색 API 호출
+	searchResp, err := s.naverClient.Search(source, req.Query, req.Limit, req.Offset+1)
 	if err != nil {
-		return nil, 0, fmt.Errorf("네이버 블로그 검색 실패: %v", err)
+		return nil, 0, fmt.Errorf("네이버 %s 검색 실패: %v", source, err)
 	}
 
 	// 스폰서 감지 (실패해도 계속 진행)
@@ -53,6 +56,17 @@ func (s *SearchImpl) SearchBlogPosts(req request.SearchQuery) ([]structure.BlogP
 		posts = []structure.BlogPost{}
 	}
 
+	// 볼 수 없는 포스트 제외 (요청한 경우)
+	if req.ExcludeUnavailable {
+		available := make([]structure.BlogPost, 0, len(posts))
+		for _, post := range posts {
+			if post.AnalysisStatus != structure.AnalysisStatusUnavailable {
+				available = append(available, post)
+			}
+		}
+		posts = available
+	}
+
 	// 네이버 API에서 반환한 총 결과 수 반환
 	return posts, searchResp.Total, nil
 }
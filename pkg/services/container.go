@@ -1,23 +1,32 @@
 package service
 
 import (
+	"github.com/sh5080/ndns-go/pkg/configs"
 	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
 	repository "github.com/sh5080/ndns-go/pkg/repositories"
 	"github.com/sh5080/ndns-go/pkg/services/api"
+	"github.com/sh5080/ndns-go/pkg/services/internal/crawler"
 	"github.com/sh5080/ndns-go/pkg/services/internal/detector"
+	"github.com/sh5080/ndns-go/pkg/services/internal/ocr"
+	constants "github.com/sh5080/ndns-go/pkg/types"
 )
 
 // NewServiceContainer는 새로운 서비스 컨테이너를 생성합니다
 func NewServiceContainer() *_interface.ServiceContainer {
-	searchService := api.NewSearchService()
-	ocrService := detector.NewOCRService()
-	postService := detector.NewPostService(ocrService)
-	ocrRepository := repository.NewOCRRepository()
+	config := configs.GetConfig()
+	ocrRepository := repository.NewOCRRepository(config)
+	ocrService := detector.NewOCRService(ocrRepository, ocr.NewEngine(config))
+	crawlerService := crawler.NewCrawlerService()
+	analysisRepository := repository.NewAnalysisRepository(constants.ANALYSIS_CACHE_MAX_ENTRIES)
+	postService := detector.NewPostService(ocrService, crawlerService, analysisRepository)
+	searchService := api.NewSearchService(postService)
 
 	return &_interface.ServiceContainer{
-		SearchService: searchService,
-		OCRService:    ocrService,
-		PostService:   postService,
-		OCRRepository: ocrRepository,
+		SearchService:      searchService,
+		OCRService:         ocrService,
+		PostService:        postService,
+		CrawlerService:     crawlerService,
+		OCRRepository:      ocrRepository,
+		AnalysisRepository: analysisRepository,
 	}
 }
@@ -1,6 +1,7 @@
 package analyzer
 
 import (
+	"slices"
 	"strings"
 
 	structure "github.com/sh5080/ndns-go/pkg/types/structures"
@@ -8,12 +9,58 @@ import (
 
 // CreateBlogPost는 기본 블로그 포스트 구조체를 생성합니다
 func CreateBlogPost(item structure.NaverSearchItem) structure.BlogPost {
-	return structure.BlogPost{
+	post := structure.BlogPost{
 		NaverSearchItem:    item,
 		IsSponsored:        false,
 		SponsorProbability: 0,
 		SponsorIndicators:  []structure.SponsorIndicator{},
 	}
+	setPostIdentity(&post)
+	return post
+}
+
+// CopyAnalysis는 분석된 포스트의 협찬 분석 결과를 다른 검색 결과 항목에 복사합니다
+// 같은 포스트가 다른 URL이나 검색 결과로 나타난 경우에 사용합니다
+func CopyAnalysis(analyzed structure.BlogPost, item structure.NaverSearchItem) structure.BlogPost {
+	post := analyzed
+	post.NaverSearchItem = item
+	post.SponsorIndicators = append([]structure.SponsorIndicator{}, analyzed.SponsorIndicators...)
+	post.ErrorCodes = append([]structure.ErrorCode(nil), analyzed.ErrorCodes...)
+	return post
+}
+
+// ApplyExtractorTrust는 본문 추출기 정보를 기록하고, 신뢰도가 낮은 추출기의 결과는 협찬 확률을 낮춥니다
+func ApplyExtractorTrust(post *structure.BlogPost, crawlResult *structure.CrawlResult) {
+	post.Extractor = crawlResult.Extractor
+	if crawlResult.Trust <= 0 || crawlResult.Trust >= 1 {
+		return
+	}
+
+	post.SponsorProbability *= crawlResult.Trust
+	for i := range post.SponsorIndicators {
+		post.SponsorIndicators[i].Probability *= crawlResult.Trust
+	}
+}
+
+// ApplyMetadata는 크롤링한 포스트 부가 정보(카테고리, 태그, 발행/수정 시각 등)를 기록합니다 (찾은 정보가 없으면 기록하지 않음)
+func ApplyMetadata(post *structure.BlogPost, crawlResult *structure.CrawlResult) {
+	if crawlResult.Metadata.IsEmpty() {
+		return
+	}
+	metadata := crawlResult.Metadata
+	metadata.Tags = append([]string(nil), crawlResult.Metadata.Tags...)
+	post.Metadata = &metadata
+}
+
+// setPostIdentity는 검색 결과 링크에서 PostID와 대표 URL을 설정합니다
+func setPostIdentity(post *structure.BlogPost) {
+	postID, err := structure.ParsePostID(post.Link)
+	if err != nil {
+		return
+	}
+
+	post.PostID = &postID
+	post.CanonicalURL = postID.CanonicalURL()
 }
 
 // CreateSponsoredBlogPost는 스폰서된 블로그 포스트 구조체를 생성합니다
@@ -39,13 +86,15 @@ func CreateSponsoredBlogPost(
 	}
 
 	// 블로그 포스트 생성
-	return structure.BlogPost{
+	post := structure.BlogPost{
 		NaverSearchItem:    item,
 		IsSponsored:        true,
 		SponsorProbability: probability,
 		SponsorIndicators:  []structure.SponsorIndicator{indicator},
 		Error:              "",
 	}
+	setPostIdentity(&post)
+	return post
 }
 
 // AddIndicator는 블로그 포스트에 협찬 표시자를 추가합니다
@@ -125,6 +174,47 @@ func UpdateBlogPostWithSponsorInfo(
 	blogPost.SponsorProbability = probability
 	blogPost.SponsorIndicators = indicators
 	blogPost.Error = "" // 협찬이 확인된 경우 에러 필드 초기화
+	blogPost.ErrorCodes = nil
+}
+
+// IsUnavailable은 삭제, 비공개 등으로 본문을 볼 수 없는 포스트인지 확인합니다
+func IsUnavailable(blogPost structure.BlogPost) bool {
+	for _, code := range blogPost.ErrorCodes {
+		if code.IsUnavailable() {
+			return true
+		}
+	}
+	return false
+}
+
+// AddErrorCode는 포스트에 실패 원인 코드를 추가합니다 (이미 있는 코드는 추가하지 않음)
+func AddErrorCode(blogPost *structure.BlogPost, code structure.ErrorCode) {
+	if code == "" {
+		return
+	}
+	for _, existing := range blogPost.ErrorCodes {
+		if existing == code {
+			return
+		}
+	}
+	blogPost.ErrorCodes = append(blogPost.ErrorCodes, code)
+}
+
+// SetAnalysisStatus는 분석 결과로 포스트의 분석 상태를 설정합니다
+// 본문을 가져오지 못했으면 failed(삭제, 비공개 등은 unavailable), 중간 단계가 실패하거나 OCR을 건너뛰어 분석을 끝내지 못했으면 partial입니다
+func SetAnalysisStatus(blogPost *structure.BlogPost, crawled bool) {
+	switch {
+	case blogPost.IsSponsored:
+		blogPost.AnalysisStatus = structure.AnalysisStatusComplete
+	case !crawled && IsUnavailable(*blogPost):
+		blogPost.AnalysisStatus = structure.AnalysisStatusUnavailable
+	case !crawled:
+		blogPost.AnalysisStatus = structure.AnalysisStatusFailed
+	case blogPost.Error != "" || slices.Contains(blogPost.ErrorCodes, structure.ErrorCodeOCRBusy):
+		blogPost.AnalysisStatus = structure.AnalysisStatusPartial
+	default:
+		blogPost.AnalysisStatus = structure.AnalysisStatusComplete
+	}
 }
 
 // 이미지 URL에서 협찬 도메인을 확인하는 함수
@@ -0,0 +1,117 @@
+package analyzer
+
+import (
+	"testing"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestSetAnalysisStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		post    structure.BlogPost
+		crawled bool
+		want    structure.AnalysisStatus
+	}{
+		{
+			name:    "협찬 근거를 찾음",
+			post:    structure.BlogPost{IsSponsored: true, Error: "OCR 실패", ErrorCodes: []structure.ErrorCode{structure.ErrorCodeOCRBusy}},
+			crawled: true,
+			want:    structure.AnalysisStatusComplete,
+		},
+		{
+			name: "본문을 가져오지 못했지만 협찬 근거를 찾음",
+			post: structure.BlogPost{IsSponsored: true, ErrorCodes: []structure.ErrorCode{structure.ErrorCodeTimeout}},
+			want: structure.AnalysisStatusComplete,
+		},
+		{
+			name: "삭제된 포스트",
+			post: structure.BlogPost{Error: "삭제된 포스트", ErrorCodes: []structure.ErrorCode{structure.ErrorCodeDeletedPost}},
+			want: structure.AnalysisStatusUnavailable,
+		},
+		{
+			name: "이웃공개 포스트",
+			post: structure.BlogPost{ErrorCodes: []structure.ErrorCode{structure.ErrorCodeBlocked, structure.ErrorCodeNeighborsOnly}},
+			want: structure.AnalysisStatusUnavailable,
+		},
+		{
+			name: "크롤링 실패",
+			post: structure.BlogPost{Error: "요청 시간 초과", ErrorCodes: []structure.ErrorCode{structure.ErrorCodeTimeout}},
+			want: structure.AnalysisStatusFailed,
+		},
+		{
+			name: "원인 코드 없이 크롤링 실패",
+			post: structure.BlogPost{},
+			want: structure.AnalysisStatusFailed,
+		},
+		{
+			name:    "본문 분석 후 OCR 실패",
+			post:    structure.BlogPost{Error: "OCR 처리 실패", ErrorCodes: []structure.ErrorCode{structure.ErrorCodeOCRFailed}},
+			crawled: true,
+			want:    structure.AnalysisStatusPartial,
+		},
+		{
+			name:    "OCR 대기열 포화로 이미지를 건너뜀",
+			post:    structure.BlogPost{ErrorCodes: []structure.ErrorCode{structure.ErrorCodeOCRBusy}},
+			crawled: true,
+			want:    structure.AnalysisStatusPartial,
+		},
+		{
+			name:    "텍스트 없는 이미지는 분석을 마친 것으로 처리",
+			post:    structure.BlogPost{ErrorCodes: []structure.ErrorCode{structure.ErrorCodeOCREmpty}},
+			crawled: true,
+			want:    structure.AnalysisStatusComplete,
+		},
+		{
+			name:    "모든 단계 분석",
+			post:    structure.BlogPost{},
+			crawled: true,
+			want:    structure.AnalysisStatusComplete,
+		},
+	}
+
+	for _, tt := range tests {
+		post := tt.post
+		SetAnalysisStatus(&post, tt.crawled)
+		if post.AnalysisStatus != tt.want {
+			t.Errorf("%s: AnalysisStatus = %s, want %s", tt.name, post.AnalysisStatus, tt.want)
+		}
+	}
+}
+
+func TestAddErrorCode(t *testing.T) {
+	post := structure.BlogPost{}
+	AddErrorCode(&post, structure.ErrorCodeOCRBusy)
+	AddErrorCode(&post, "")
+	AddErrorCode(&post, structure.ErrorCodeOCRBusy)
+	AddErrorCode(&post, structure.ErrorCodeTimeout)
+
+	if len(post.ErrorCodes) != 2 || post.ErrorCodes[0] != structure.ErrorCodeOCRBusy || post.ErrorCodes[1] != structure.ErrorCodeTimeout {
+		t.Errorf("ErrorCodes = %v, want [ocr_busy timeout]", post.ErrorCodes)
+	}
+}
+
+func TestApplyMetadata(t *testing.T) {
+	post := structure.BlogPost{}
+	ApplyMetadata(&post, &structure.CrawlResult{})
+	if post.Metadata != nil {
+		t.Errorf("부가 정보가 없는데 Metadata = %+v", post.Metadata)
+	}
+
+	crawlResult := &structure.CrawlResult{Extractor: "generic", Trust: 0.5, Metadata: structure.PostMetadata{Category: "카페 투어", Tags: []string{"성수동"}}}
+	post = structure.BlogPost{SponsorProbability: 0.8}
+	ApplyMetadata(&post, crawlResult)
+	if post.Metadata == nil || post.Metadata.Category != "카페 투어" || len(post.Metadata.Tags) != 1 {
+		t.Fatalf("Metadata = %+v, want 크롤링 결과의 부가 정보", post.Metadata)
+	}
+	// 부가 정보만 기록하고 추출기 정보와 협찬 확률은 ApplyExtractorTrust에서 처리
+	if post.Extractor != "" || post.SponsorProbability != 0.8 {
+		t.Errorf("ApplyMetadata가 Extractor = %q, SponsorProbability = %v로 바꾸었습니다", post.Extractor, post.SponsorProbability)
+	}
+
+	// 크롤링 결과의 태그를 바꿔도 기록한 부가 정보는 바뀌지 않음
+	crawlResult.Metadata.Tags[0] = "연남동"
+	if post.Metadata.Tags[0] != "성수동" {
+		t.Errorf("Metadata.Tags = %q, want 복사한 태그", post.Metadata.Tags)
+	}
+}
@@ -0,0 +1,61 @@
+package crawler
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+)
+
+// reportBlockedStatus는 응답 상태 코드가 차단을 의미하면 호스트 차단을 기록하고 BlockedError를 반환합니다 (아니면 nil)
+// 403은 주소 하나에 대한 응답(비공개, 삭제된 포스트 등)인 경우가 많아 같은 호스트에서 반복될 때만 차단으로 기록합니다
+func reportBlockedStatus(host string, statusCode int) error {
+	switch {
+	case slices.Contains(constants.BLOCK_STATUS_CODES, statusCode):
+		return transport.ReportBlocked(host, transport.BlockReasonStatus, fmt.Sprintf("HTTP %d", statusCode))
+	case statusCode == http.StatusForbidden:
+		if blocked := transport.ReportForbidden(host); blocked != nil {
+			return blocked
+		}
+	}
+	return nil
+}
+
+// detectBlockPage는 정상 응답으로 받은 페이지가 캡차, 접근 제한 안내, 빈 페이지인지 확인합니다
+// 본문이 긴 페이지는 표식 문구가 포함되어 있어도 일반 포스트로 간주합니다
+func detectBlockPage(doc *goquery.Document, rawHTML string) (transport.BlockReason, string) {
+	body := doc.Find("body")
+	if body.Length() == 0 {
+		body = doc.Selection
+	}
+
+	text := cleanText(body.Text())
+	if utf8.RuneCountInString(text) > constants.BLOCK_PAGE_MAX_TEXT_LENGTH {
+		return "", ""
+	}
+
+	lowerHTML := strings.ToLower(rawHTML)
+	for _, marker := range constants.CAPTCHA_PAGE_MARKERS {
+		if strings.Contains(lowerHTML, strings.ToLower(marker)) {
+			return transport.BlockReasonCaptcha, marker
+		}
+	}
+
+	for _, marker := range constants.BLOCK_PAGE_MARKERS {
+		if strings.Contains(text, marker) {
+			return transport.BlockReasonBlockPage, marker
+		}
+	}
+
+	// 텍스트, 이미지, 프레임이 모두 없는 페이지 (차단 시 빈 프레임셋이나 빈 문서가 내려옴)
+	if text == "" && body.Find("img, iframe, frame").Length() == 0 {
+		return transport.BlockReasonEmptyPage, ""
+	}
+	return "", ""
+}
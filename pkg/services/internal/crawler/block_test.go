@@ -0,0 +1,131 @@
+package crawler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestDetectBlockPage(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    transport.BlockReason
+	}{
+		{"blocked/captcha.html", transport.BlockReasonCaptcha},
+		{"blocked/throttled.html", transport.BlockReasonBlockPage},
+		{"blocked/empty_frameset.html", transport.BlockReasonEmptyPage},
+		// 정상 페이지는 차단으로 판단하지 않음
+		{"naver/se_one.html", ""},
+		{"naver/frameset.html", ""},
+		{"naver/old_post.html", ""},
+		{"tistory/odyssey.html", ""},
+		{"generic/brunch.html", ""},
+	}
+
+	for _, tt := range tests {
+		raw, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+		if err != nil {
+			t.Fatalf("픽스처 읽기 실패: %v", err)
+		}
+		doc := loadFixture(t, tt.fixture)
+
+		if got, detail := detectBlockPage(doc, string(raw)); got != tt.want {
+			t.Errorf("detectBlockPage(%s) = (%q, %q), want %q", tt.fixture, got, detail, tt.want)
+		}
+	}
+}
+
+func TestDetectBlockPageIgnoresLongPosts(t *testing.T) {
+	// 차단 안내 문구를 인용한 일반 포스트
+	html := "<html><body><p>" + strings.Repeat("크롤링을 하다 보면 비정상적인 접근으로 차단되기도 합니다. ", 50) + "</p></body></html>"
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("HTML 파싱 실패: %v", err)
+	}
+
+	if got, _ := detectBlockPage(doc, html); got != "" {
+		t.Errorf("detectBlockPage(긴 포스트) = %q, want 빈 값", got)
+	}
+}
+
+func TestFetchHTMLBlocked(t *testing.T) {
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+	t.Cleanup(transport.ResetBlocked)
+
+	captcha, err := os.ReadFile(filepath.Join("testdata", "blocked", "captcha.html"))
+	if err != nil {
+		t.Fatalf("픽스처 읽기 실패: %v", err)
+	}
+
+	// 차단 상태는 호스트별로 기록되므로 경우마다 다른 호스트 이름 사용
+	tests := []struct {
+		name    string
+		host    string
+		handler http.HandlerFunc
+		want    transport.BlockReason
+	}{
+		{"상태 코드", "127.0.0.1", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}, transport.BlockReasonStatus},
+		{"캡차", "localhost", func(w http.ResponseWriter, r *http.Request) {
+			w.Write(captcha)
+		}, transport.BlockReasonCaptcha},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(tt.handler)
+		_, err := fetchHTML(strings.Replace(server.URL, "127.0.0.1", tt.host, 1))
+		server.Close()
+
+		var blocked *transport.BlockedError
+		if !errors.As(err, &blocked) {
+			t.Errorf("%s: fetchHTML 에러 = %v, want BlockedError", tt.name, err)
+			continue
+		}
+		if blocked.Reason != tt.want {
+			t.Errorf("%s: 차단 사유 = %q, want %q", tt.name, blocked.Reason, tt.want)
+		}
+	}
+}
+
+func TestFetchHTMLForbidden(t *testing.T) {
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+	transport.ResetBlocked()
+	t.Cleanup(transport.ResetBlocked)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	// 403 한 번은 해당 주소만 실패로 처리하고 호스트는 차단하지 않음
+	_, err := fetchHTML(server.URL + "/private-post")
+	var blocked *transport.BlockedError
+	if errors.As(err, &blocked) || structure.ErrorCodeOf(err) != structure.ErrorCodeCrawlFailed {
+		t.Fatalf("403 fetchHTML 에러 = %v, want crawl_failed", err)
+	}
+	if err := transport.CheckBlocked("127.0.0.1"); err != nil {
+		t.Fatalf("403 한 번으로 호스트가 차단되었습니다: %v", err)
+	}
+
+	// 같은 호스트에서 403이 반복되면 차단
+	for i := 1; i < constants.BLOCK_FORBIDDEN_THRESHOLD; i++ {
+		_, err = fetchHTML(fmt.Sprintf("%s/post-%d", server.URL, i))
+	}
+	if !errors.As(err, &blocked) || blocked.Reason != transport.BlockReasonStatus {
+		t.Errorf("반복된 403 fetchHTML 에러 = %v, want BlockedError", err)
+	}
+}
@@ -0,0 +1,313 @@
+package crawler
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// 일반 HTML 본문에서 블록으로 인식하는 요소
+const genericBlockSelector = "p, h2, h3, h4, li, blockquote, img, hr, iframe, video, figure[data-ke-type='opengraph'], figure[data-ke-type='map']"
+
+// 다른 블록에 포함되어 별도 블록으로 만들지 않는 상위 요소
+const genericBlockContainers = "blockquote, figure[data-ke-type='opengraph'], figure[data-ke-type='map']"
+
+// setContentBlocks는 본문 영역을 블록 목록으로 변환하여 결과에 저장하고 본문 텍스트를 채웁니다
+func setContentBlocks(result *structure.CrawlResult, contentArea *goquery.Selection) {
+	if contentArea == nil || contentArea.Length() == 0 {
+		return
+	}
+
+	setBlocks(result, extractContentBlocks(contentArea))
+}
+
+// setBlocks는 블록에 순서와 상대 위치를 부여하여 결과에 저장하고 본문 텍스트를 채웁니다
+func setBlocks(result *structure.CrawlResult, blocks []structure.ContentBlock) {
+	var texts []string
+	for i := range blocks {
+		blocks[i].Index = i
+		if len(blocks) > 1 {
+			blocks[i].Position = float64(i) / float64(len(blocks)-1)
+		}
+		if blocks[i].Type == structure.BlockTypeText || blocks[i].Type == structure.BlockTypeQuote {
+			texts = append(texts, blocks[i].Text)
+		}
+	}
+
+	result.Blocks = blocks
+	result.Content = strings.Join(texts, "\n")
+}
+
+// findContentArea는 선택자 순서대로 본문 영역을 찾고, 찾지 못하면 문서 전체를 반환합니다
+func findContentArea(doc *goquery.Document, selectors []string) *goquery.Selection {
+	if contentArea := editorContentArea(doc, selectors); contentArea != nil {
+		return contentArea
+	}
+	return doc.Selection
+}
+
+// extractContentBlocks는 본문 영역에서 블록을 문서 순서대로 추출합니다
+func extractContentBlocks(contentArea *goquery.Selection) []structure.ContentBlock {
+	// 스마트에디터 ONE 컴포넌트 구조
+	components := contentArea.Find(".se-component").FilterFunction(func(i int, s *goquery.Selection) bool {
+		return s.ParentsFiltered(".se-component").Length() == 0
+	})
+	if components.Length() > 0 {
+		var blocks []structure.ContentBlock
+		components.Each(func(i int, component *goquery.Selection) {
+			blocks = append(blocks, smartEditorBlocks(component)...)
+		})
+		return blocks
+	}
+
+	return genericBlocks(contentArea)
+}
+
+// smartEditorBlocks는 스마트에디터 ONE 컴포넌트를 블록으로 변환합니다
+func smartEditorBlocks(component *goquery.Selection) []structure.ContentBlock {
+	var blocks []structure.ContentBlock
+
+	switch {
+	case component.HasClass("se-text"), component.HasClass("se-sectionTitle"):
+		component.Find(".se-text-paragraph").Each(func(i int, p *goquery.Selection) {
+			if text := cleanText(p.Text()); text != "" {
+				blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeText, Text: text})
+			}
+		})
+
+	case component.HasClass("se-quotation"):
+		if text := cleanText(component.Find(".se-quote").Text()); text != "" {
+			blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeQuote, Text: text})
+		} else if text := cleanText(component.Text()); text != "" {
+			blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeQuote, Text: text})
+		}
+
+	case component.HasClass("se-sticker"):
+		if imgURL := imageSource(component.Find("img").First()); imgURL != "" {
+			blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeSticker, ImageURL: imgURL})
+		}
+
+	case component.HasClass("se-image"), component.HasClass("se-imageStrip"), component.HasClass("se-imageGroup"):
+		caption := cleanText(component.Find(".se-caption").Text())
+		component.Find(".se-module-image, .se-imageStrip-container > *").Each(func(i int, module *goquery.Selection) {
+			img := module.Find("img").First()
+			imgURL := imageSource(img)
+			if imgURL == "" {
+				// 이미지 태그가 없으면 data-linkdata 속성의 원본 URL 사용
+				imgURL = linkDataSource(module.Find("[data-linkdata]").First())
+			}
+			if imgURL == "" || isExcludedImage(imgURL) {
+				return
+			}
+			blockType := structure.BlockTypeImage
+			if isStickerImage(img, imgURL) && !strings.Contains(imgURL, constants.NAVER_POST_IMAGE_DOMAIN) {
+				blockType = structure.BlockTypeSticker
+			}
+			blocks = append(blocks, structure.ContentBlock{Type: blockType, ImageURL: imgURL, Caption: caption})
+		})
+
+	case component.HasClass("se-oglink"):
+		blocks = append(blocks, structure.ContentBlock{
+			Type:     structure.BlockTypeLinkCard,
+			Text:     cleanText(component.Find(".se-oglink-title").Text()),
+			ImageURL: imageSource(component.Find(".se-oglink-thumbnail img").First()),
+			LinkURL:  component.Find("a[href]").First().AttrOr("href", ""),
+		})
+
+	case component.HasClass("se-placesMap"), component.HasClass("se-map"):
+		blocks = append(blocks, structure.ContentBlock{
+			Type:    structure.BlockTypeMap,
+			Text:    cleanText(component.Find(".se-map-title").First().Text()),
+			LinkURL: component.Find("a[href]").First().AttrOr("href", ""),
+		})
+
+	case component.HasClass("se-video"), component.HasClass("se-oembed"):
+		blocks = append(blocks, structure.ContentBlock{
+			Type:    structure.BlockTypeVideo,
+			Text:    cleanText(component.Find(".se-video-title, .se-oembed-title").First().Text()),
+			LinkURL: component.Find("iframe").First().AttrOr("src", ""),
+		})
+
+	case component.HasClass("se-horizontalLine"):
+		blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeHR})
+
+	default:
+		if text := cleanText(component.Text()); text != "" {
+			blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeText, Text: text})
+		}
+	}
+
+	return blocks
+}
+
+// genericBlocks는 스마트에디터 구조가 아닌 본문(티스토리, 구버전 에디터 등)을 블록으로 변환합니다
+func genericBlocks(contentArea *goquery.Selection) []structure.ContentBlock {
+	var blocks []structure.ContentBlock
+
+	contentArea.Find(genericBlockSelector).Each(func(i int, elem *goquery.Selection) {
+		// 인용구, 카드 내부 요소는 상위 블록에 포함
+		if elem.ParentsFiltered(genericBlockContainers).Length() > 0 {
+			return
+		}
+
+		switch goquery.NodeName(elem) {
+		case "img":
+			imgURL := imageSource(elem)
+			if imgURL == "" {
+				return
+			}
+			if isStickerImage(elem, imgURL) {
+				blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeSticker, ImageURL: imgURL})
+				return
+			}
+			if isExcludedImage(imgURL) {
+				return
+			}
+			blocks = append(blocks, structure.ContentBlock{
+				Type:     structure.BlockTypeImage,
+				ImageURL: imgURL,
+				Caption:  cleanText(elem.Closest("figure").Find("figcaption").Text()),
+			})
+
+		case "hr":
+			blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeHR})
+
+		case "iframe", "video":
+			src := elem.AttrOr("src", "")
+			if src == "" {
+				src = elem.Find("source").First().AttrOr("src", "")
+			}
+			if src == "" {
+				return
+			}
+			blockType := structure.BlockTypeVideo
+			if strings.Contains(src, "map") {
+				blockType = structure.BlockTypeMap
+			}
+			blocks = append(blocks, structure.ContentBlock{Type: blockType, LinkURL: src})
+
+		case "blockquote":
+			if text := cleanText(elem.Text()); text != "" {
+				blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeQuote, Text: text})
+			}
+
+		case "figure":
+			blockType := structure.BlockTypeLinkCard
+			if elem.AttrOr("data-ke-type", "") == "map" {
+				blockType = structure.BlockTypeMap
+			}
+			blocks = append(blocks, structure.ContentBlock{
+				Type:     blockType,
+				Text:     cleanText(elem.Find(".og-title, .map-title").First().Text()),
+				ImageURL: imageSource(elem.Find("img").First()),
+				LinkURL:  elem.Find("a[href]").First().AttrOr("href", ""),
+			})
+
+		default:
+			// 목록 안의 문단은 목록 항목 단위로 수집
+			if goquery.NodeName(elem) == "p" && elem.ParentsFiltered("li").Length() > 0 {
+				return
+			}
+			if text := cleanText(elem.Text()); text != "" {
+				blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeText, Text: text})
+			}
+		}
+	})
+
+	// 문단 태그 없이 줄바꿈으로만 작성된 본문
+	if len(blocks) == 0 {
+		for _, line := range strings.Split(contentArea.Text(), "\n") {
+			if text := cleanText(line); text != "" {
+				blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeText, Text: text})
+			}
+		}
+	}
+
+	return blocks
+}
+
+// imageSource는 지연 로딩 속성을 포함하여 이미지 URL을 추출합니다
+func imageSource(img *goquery.Selection) string {
+	if img.Length() == 0 {
+		return ""
+	}
+
+	imgURL := img.AttrOr("data-lazy-src", "")
+	if imgURL == "" {
+		imgURL = img.AttrOr("src", "")
+	}
+	if imgURL == "" {
+		imgURL = img.AttrOr("data-src", "")
+	}
+
+	// 프로토콜 생략 URL 처리
+	if strings.HasPrefix(imgURL, "//") {
+		imgURL = "https:" + imgURL
+	}
+	if !strings.HasPrefix(imgURL, "http://") && !strings.HasPrefix(imgURL, "https://") {
+		return ""
+	}
+
+	// 블러 처리된 미리보기 이미지는 원본 크기로 변경
+	if strings.HasSuffix(imgURL, "w80_blur") {
+		imgURL = strings.Replace(imgURL, "w80_blur", "w773", 1)
+	}
+	return imgURL
+}
+
+// linkDataSource는 네이버 에디터의 data-linkdata 속성(JSON)에서 이미지 URL을 추출합니다
+func linkDataSource(elem *goquery.Selection) string {
+	linkData := elem.AttrOr("data-linkdata", "")
+	if linkData == "" {
+		return ""
+	}
+
+	var data struct {
+		Src string `json:"src"`
+	}
+	if err := json.Unmarshal([]byte(linkData), &data); err != nil {
+		return ""
+	}
+	if strings.HasSuffix(data.Src, "w80_blur") {
+		return strings.Replace(data.Src, "w80_blur", "w773", 1)
+	}
+	return data.Src
+}
+
+// isStickerImage는 이미지가 스티커 또는 이모티콘인지 확인합니다
+func isStickerImage(img *goquery.Selection, imgURL string) bool {
+	for _, domain := range constants.STICKER_DOMAINS {
+		if strings.Contains(imgURL, domain) {
+			return true
+		}
+	}
+	if img.ParentsFiltered("[class*='sticker']").Length() > 0 {
+		return true
+	}
+	if isTistorySticker(img, imgURL) {
+		return true
+	}
+
+	// 그 외 플랫폼은 URL, class, alt의 이모티콘 키워드로 확인
+	attributes := strings.ToLower(imgURL + " " + img.AttrOr("class", "") + " " + img.AttrOr("alt", ""))
+	for _, keyword := range constants.GENERIC_STICKER_KEYWORDS {
+		if strings.Contains(attributes, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// isExcludedImage는 이미지가 제외 패턴에 해당하는지 확인합니다
+func isExcludedImage(imgURL string) bool {
+	for _, pattern := range constants.EXCLUDE_IMAGE_PATTERNS {
+		if strings.Contains(imgURL, pattern) {
+			return true
+		}
+	}
+	return false
+}
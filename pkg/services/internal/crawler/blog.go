@@ -1,8 +1,10 @@
 package crawler
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
 	"strings"
@@ -10,99 +12,150 @@ import (
 
 	"github.com/PuerkitoBio/goquery"
 
+	"github.com/sh5080/ndns-go/pkg/transport"
 	constants "github.com/sh5080/ndns-go/pkg/types"
 	structure "github.com/sh5080/ndns-go/pkg/types/structures"
 	"github.com/sh5080/ndns-go/pkg/utils"
 )
 
-// CrawlBlogPost는 블로그 포스트 URL에서 콘텐츠를 크롤링합니다
-// is2025OrLater가 true일 경우 마지막 데이터는 가져오지 않습니다.
-func CrawlBlogPost(url string, is2025OrLater bool) (*structure.CrawlResult, error) {
-	if url == "" {
-		return nil, fmt.Errorf("URL이 비어 있습니다")
+// fetchHTML은 URL에서 HTML을 가져와 goquery.Document로 반환합니다
+// 호스트별 요청 제한과 일시적인 실패의 재시도는 공유 transport에서 처리합니다
+// 페이지 캐시가 설정되어 있으면 저장된 페이지를 사용하고, 보관 시간이 지난 페이지는 조건부 요청으로 재검증합니다
+func fetchHTML(url string) (*goquery.Document, error) {
+	cache := sharedPageCache
+	key := pageCacheKey(url)
+
+	var cached *pageCacheEntry
+	if cache != nil {
+		entry, fresh := cache.Get(key)
+		if entry != nil && fresh {
+			utils.RecordCacheResult("page", "hit")
+			return parseHTML(entry.Body)
+		}
+		cached = entry
 	}
 
-	// URL 정규화
-	url = normalizeURL(url)
-	utils.DebugLog("크롤링 시작: %s (2025년 이후 포스트: %v)\n", url, is2025OrLater)
+	client := transport.NewClient(constants.TIMEOUT)
 
-	// 결과 초기화
-	result := &structure.CrawlResult{
-		URL: url,
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("요청 생성 실패: %v", err)
 	}
 
-	// 네이버 블로그인 경우 특별 처리
-	if strings.Contains(url, "blog.naver.com") {
-		// 먼저 프레임셋 페이지 가져오기
-		framesetDoc, err := fetchHTML(url)
-		if err != nil {
-			return nil, fmt.Errorf("프레임셋 페이지 가져오기 실패: %v", err)
+	// User-Agent 설정
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
+
+	// 같은 URL로 저장된 페이지가 있으면 조건부 요청
+	if cached != nil && cached.URL == url {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
 		}
+	}
 
-		// iframe 태그에서 실제 콘텐츠 URL 추출
-		iframeURL := extractNaverIframeURL(framesetDoc, url)
-		if iframeURL != "" {
-			contentDoc, err := fetchHTML(iframeURL)
-			if err != nil {
-				return nil, fmt.Errorf("iframe 내부 콘텐츠 가져오기 실패: %v", err)
-			}
-			// 2025년 이후 포스트 여부에 따라 다른 파싱 함수 호출
-			if is2025OrLater {
-				parseNaverBlogFirst(contentDoc, result)
-			} else {
-				parseNaverBlogFull(contentDoc, result)
-			}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("요청 실행 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 변경되지 않았으면 저장된 페이지의 보관 시간을 갱신하여 사용
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		utils.RecordCacheResult("page", "revalidated")
+		cached.StoredAt = time.Now()
+		if err := cache.Put(*cached); err != nil {
+			fmt.Printf("페이지 캐시 갱신 실패 (무시됨): %v\n", err)
 		}
-	} else {
-		return nil, fmt.Errorf("지원하지 않는 블로그 플랫폼입니다")
+		return parseHTML(cached.Body)
+	}
+	if cache != nil {
+		utils.RecordCacheResult("page", "miss")
 	}
-	return result, nil
-}
 
-// fetchHTML은 URL에서 HTML을 가져와 goquery.Document로 반환합니다
-func fetchHTML(url string) (*goquery.Document, error) {
-	var (
-		resp *http.Response
-		err  error
-	)
+	// 삭제된 포스트 응답을 먼저 확인하고, 차단 상태 코드이면 해당 호스트 요청을 잠시 중지
+	if err := detectUnavailableStatus(resp.StatusCode); err != nil {
+		return nil, err
+	}
+	if err := reportBlockedStatus(req.URL.Hostname(), resp.StatusCode); err != nil {
+		return nil, err
+	}
+	// 반복되지 않은 403은 해당 주소만 접근할 수 없는 것으로 처리
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, structure.NewAnalysisError(structure.ErrorCodeCrawlFailed, "접근이 거부된 페이지 (HTTP 403)", nil)
+	}
 
-	client := &http.Client{
-		Timeout: constants.TIMEOUT,
+	// PDF, 이미지 등 HTML이 아닌 문서는 분석하지 않음
+	if contentType := resp.Header.Get("Content-Type"); !isHTMLContentType(contentType) {
+		return nil, structure.NewAnalysisError(structure.ErrorCodeUnsupportedPlatform, fmt.Sprintf("HTML이 아닌 문서 (%s)", contentType), nil)
 	}
 
-	// 재시도 로직 구현
-	for attempt := 0; attempt < constants.CRAWL_MAX_RETRIES; attempt++ {
-		if attempt > 0 {
-			time.Sleep(constants.CRAWL_RETRY_DELAY)
-		}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("응답 본문 읽기 실패: %v", err)
+	}
 
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("요청 생성 실패: %v", err)
-		}
+	// EUC-KR/CP949 등 UTF-8이 아닌 페이지는 파싱 전에 UTF-8로 변환 (캐시에도 변환된 본문을 저장)
+	body, _, err = decodeHTML(body, resp.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
 
-		// User-Agent 설정
-		req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
+	doc, err := parseHTML(body)
+	if err != nil {
+		return nil, err
+	}
 
-		resp, err = client.Do(req)
-		if err == nil {
-			break
-		}
+	// 삭제, 비공개 안내 페이지는 빈 페이지로 보일 수 있으므로 차단 여부보다 먼저 확인
+	if err := detectUnavailablePage(doc); err != nil {
+		return nil, err
+	}
 
-		if attempt == constants.CRAWL_MAX_RETRIES-1 {
-			return nil, fmt.Errorf("요청 실행 실패 (%d번째 시도): %v", attempt+1, err)
+	// 캡차, 접근 제한 안내, 빈 페이지는 파싱 결과로 사용하지 않음
+	if reason, detail := detectBlockPage(doc, string(body)); reason != "" {
+		return nil, transport.ReportBlocked(req.URL.Hostname(), reason, detail)
+	}
+
+	// 정상 응답만 저장 (프레임 페이지는 본문 페이지와 캐시 키가 같을 수 있으므로 제외)
+	if cache != nil && resp.StatusCode == http.StatusOK && !isFramePage(doc) {
+		entry := pageCacheEntry{
+			Key:          key,
+			URL:          url,
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+			StoredAt:     time.Now(),
+			Body:         body,
+		}
+		if err := cache.Put(entry); err != nil {
+			fmt.Printf("페이지 캐시 저장 실패 (무시됨): %v\n", err)
 		}
 	}
-	defer resp.Body.Close()
 
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	return doc, nil
+}
+
+// isHTMLContentType은 Content-Type이 HTML로 파싱할 수 있는 문서인지 확인합니다
+// Content-Type이 없으면 HTML로 간주합니다
+func isHTMLContentType(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	return mediaType == "" || strings.HasPrefix(mediaType, "text/") || strings.Contains(mediaType, "html") || strings.HasSuffix(mediaType, "xml")
+}
+
+// parseHTML은 HTML 본문을 goquery.Document로 파싱합니다
+func parseHTML(body []byte) (*goquery.Document, error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("HTML 파싱 실패: %v", err)
 	}
-
 	return doc, nil
 }
 
+// isFramePage는 본문 대신 iframe으로 본문 페이지를 불러오는 프레임 페이지인지 확인합니다
+func isFramePage(doc *goquery.Document) bool {
+	return doc.Find("iframe#mainFrame, iframe#cafe_main").Length() > 0
+}
+
 // extractNaverIframeURL은 네이버 블로그 프레임셋에서 실제 콘텐츠 iframe URL을 추출합니다
 func extractNaverIframeURL(doc *goquery.Document, originalURL string) string {
 	iframeURL := ""
@@ -133,6 +186,8 @@ func parseNaverBlogFirst(doc *goquery.Document, result *structure.CrawlResult) {
 	extractFirstImageOnly(doc, result)
 	// 첫 번째 문단 추출
 	extractFirstParagraphOnly(doc, result)
+	// 본문 블록 추출
+	setContentBlocks(result, findContentArea(doc, constants.CONTENT_SELECTORS))
 }
 
 // parseNaverBlogFull은 네이버 블로그 HTML에서 모든 데이터를 파싱합니다 (2025년 이전)
@@ -143,6 +198,8 @@ func parseNaverBlogFull(doc *goquery.Document, result *structure.CrawlResult) {
 	extractFirstImage(doc, result)
 	// 첫 문단 추출
 	extractFirstParagraph(doc, result)
+	// 본문 블록 추출
+	setContentBlocks(result, findContentArea(doc, constants.CONTENT_SELECTORS))
 }
 
 // extractFirstStickerOnly는 첫 번째 스티커만 추출합니다 (2025년 이후 포스트용)
@@ -223,19 +280,8 @@ func extractFirstImageOnly(doc *goquery.Document, result *structure.CrawlResult)
 	// 모든 이미지 URL을 저장할 슬라이스
 	var imageURLs []string
 
-	// 본문 영역 찾기
-	var contentArea *goquery.Selection
-	for _, selector := range constants.CONTENT_SELECTORS {
-		contentArea = doc.Find(selector).First()
-		if contentArea.Length() > 0 {
-			break
-		}
-	}
-
-	// 본문 영역을 찾지 못한 경우 전체 HTML 사용
-	if contentArea == nil || contentArea.Length() == 0 {
-		contentArea = doc.Selection
-	}
+	// 본문 영역 찾기 (찾지 못한 경우 전체 HTML 사용)
+	contentArea := findContentArea(doc, constants.CONTENT_SELECTORS)
 
 	// 이미지 검색 (첫 번째 이미지만 찾음)
 	contentArea.Find("img").Each(func(i int, img *goquery.Selection) {
@@ -294,20 +340,8 @@ func extractCommonParagraphs(doc *goquery.Document, maxParagraphs int) []string
 	// 모든 문단을 저장할 슬라이스
 	var paragraphs []string
 
-	// 본문 영역 찾기
-	var contentArea *goquery.Selection
-	for _, selector := range constants.CONTENT_SELECTORS {
-		selected := doc.Find(selector)
-		if selected.Length() > 0 {
-			contentArea = selected.First()
-			break
-		}
-	}
-
-	// 본문 영역을 찾지 못한 경우 전체 HTML 사용
-	if contentArea == nil || contentArea.Length() == 0 {
-		contentArea = doc.Selection
-	}
+	// 본문 영역 찾기 (찾지 못한 경우 전체 HTML 사용)
+	contentArea := findContentArea(doc, constants.CONTENT_SELECTORS)
 
 	// 문단 선택자 확인
 	paragraphSelectors := []string{
@@ -547,19 +581,8 @@ func extractFirstImage(doc *goquery.Document, result *structure.CrawlResult) {
 	// 모든 이미지 URL을 저장할 슬라이스
 	var imageURLs []string
 
-	// 본문 영역 찾기
-	var contentArea *goquery.Selection
-	for _, selector := range constants.CONTENT_SELECTORS {
-		contentArea = doc.Find(selector).First()
-		if contentArea.Length() > 0 {
-			break
-		}
-	}
-
-	// 본문 영역을 찾지 못한 경우 전체 HTML 사용
-	if contentArea == nil || contentArea.Length() == 0 {
-		contentArea = doc.Selection
-	}
+	// 본문 영역 찾기 (찾지 못한 경우 전체 HTML 사용)
+	contentArea := findContentArea(doc, constants.CONTENT_SELECTORS)
 
 	// 0. 인라인 이미지 및 data-linkdata 속성 확인 (협찬 이미지에 특히 중요)
 	contentArea.Find(".se-inline-image, .se-module-image").Each(func(i int, imgContainer *goquery.Selection) {
@@ -755,19 +778,8 @@ func extractFirstParagraph(doc *goquery.Document, result *structure.CrawlResult)
 		"blockquote",              // 일반 인용구
 	}
 
-	var contentArea *goquery.Selection
-	for _, selector := range constants.CONTENT_SELECTORS {
-		selected := doc.Find(selector)
-		if selected.Length() > 0 {
-			contentArea = selected.First()
-			break
-		}
-	}
-
-	// 본문 영역을 찾지 못한 경우 전체 HTML 사용
-	if contentArea == nil || contentArea.Length() == 0 {
-		contentArea = doc.Selection
-	}
+	// 본문 영역 찾기 (찾지 못한 경우 전체 HTML 사용)
+	contentArea := findContentArea(doc, constants.CONTENT_SELECTORS)
 
 	for _, selector := range quotationSelectors {
 		quotes := contentArea.Find(selector)
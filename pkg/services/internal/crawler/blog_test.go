@@ -0,0 +1,45 @@
+package crawler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestFetchHTMLRejectsNonHTML(t *testing.T) {
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF-1.4"))
+	}))
+	defer server.Close()
+
+	_, err := fetchHTML(server.URL)
+	if !errors.Is(err, structure.ErrUnsupportedPlatform) {
+		t.Errorf("fetchHTML(PDF) 에러 = %v, want %v", err, structure.ErrUnsupportedPlatform)
+	}
+}
+
+func TestIsHTMLContentType(t *testing.T) {
+	tests := map[string]bool{
+		"":                                true,
+		"text/html; charset=UTF-8":        true,
+		"application/xhtml+xml":           true,
+		"text/plain":                      true,
+		"application/pdf":                 false,
+		"image/jpeg":                      false,
+		"application/json; charset=utf-8": false,
+	}
+
+	for contentType, want := range tests {
+		if got := isHTMLContentType(contentType); got != want {
+			t.Errorf("isHTMLContentType(%q) = %v, want %v", contentType, got, want)
+		}
+	}
+}
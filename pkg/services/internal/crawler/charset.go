@@ -0,0 +1,149 @@
+package crawler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/transform"
+)
+
+// 문자셋 판별에 사용하는 최대 바이트 수
+const charsetSniffLength = 64 * 1024
+
+// WHATWG 인코딩 표준에는 없지만 국내 서버가 선언하는 EUC-KR(CP949) 문자셋 이름
+var charsetAliases = map[string]string{
+	"cp949":         "euc-kr",
+	"ms949":         "euc-kr",
+	"x-windows-949": "euc-kr",
+}
+
+// decodeHTML은 HTML 본문의 문자셋을 판별하여 UTF-8로 변환합니다
+// Content-Type 헤더, BOM, <meta charset> 순서로 확인하고, 선언이 없으면 바이트 패턴으로 EUC-KR(CP949) 여부를 판별합니다
+// 판별한 문자셋 이름을 함께 반환합니다
+func decodeHTML(body []byte, contentType string) ([]byte, string, error) {
+	enc, name := detectCharset(body, contentType)
+	if name == "utf-8" {
+		return body, name, nil
+	}
+
+	decoded, err := io.ReadAll(transform.NewReader(bytes.NewReader(body), enc.NewDecoder()))
+	if err != nil {
+		return nil, name, fmt.Errorf("문자셋 변환 실패 (%s): %v", name, err)
+	}
+	return decoded, name, nil
+}
+
+// detectCharset은 HTML 본문의 문자셋을 판별합니다
+func detectCharset(body []byte, contentType string) (encoding.Encoding, string) {
+	sniff := body
+	if len(sniff) > charsetSniffLength {
+		sniff = sniff[:charsetSniffLength]
+	}
+
+	// 헤더, BOM, meta 태그로 선언된 문자셋
+	enc, name, certain := charset.DetermineEncoding(sniff, normalizeContentTypeCharset(contentType))
+	if certain {
+		return enc, name
+	}
+	// meta 태그가 앞쪽 1024바이트 밖에 있는 경우 (긴 head를 가진 구버전 페이지)
+	if label := metaCharset(sniff); label != "" {
+		if declared, declaredName := charset.Lookup(normalizeCharsetLabel(label)); declared != nil {
+			return declared, declaredName
+		}
+	}
+
+	// 선언이 없으면 바이트 패턴으로 판별
+	switch {
+	case utf8.Valid(sniff):
+		return encoding.Nop, "utf-8"
+	case isLikelyEUCKR(sniff):
+		return korean.EUCKR, "euc-kr"
+	default:
+		return enc, name
+	}
+}
+
+// normalizeCharsetLabel은 표준에 없는 문자셋 이름(cp949, ms949 등)을 표준 이름으로 바꿉니다
+func normalizeCharsetLabel(label string) string {
+	if alias, exists := charsetAliases[strings.ToLower(strings.TrimSpace(label))]; exists {
+		return alias
+	}
+	return label
+}
+
+// normalizeContentTypeCharset은 Content-Type 헤더의 charset 파라미터를 표준 이름으로 바꿉니다
+func normalizeContentTypeCharset(contentType string) string {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || params["charset"] == "" {
+		return contentType
+	}
+
+	label := normalizeCharsetLabel(params["charset"])
+	if label == params["charset"] {
+		return contentType
+	}
+	params["charset"] = label
+	return mime.FormatMediaType(mediaType, params)
+}
+
+// metaCharset은 HTML 전체에서 <meta charset> 또는 http-equiv Content-Type 선언을 찾습니다
+func metaCharset(body []byte) string {
+	lower := bytes.ToLower(body)
+	for offset := 0; ; {
+		index := bytes.Index(lower[offset:], []byte("<meta"))
+		if index < 0 {
+			return ""
+		}
+		start := offset + index
+		end := bytes.IndexByte(lower[start:], '>')
+		if end < 0 {
+			return ""
+		}
+		tag := lower[start : start+end]
+		offset = start + end
+
+		if position := bytes.Index(tag, []byte("charset=")); position >= 0 {
+			value := bytes.TrimLeft(tag[position+len("charset="):], `"' `)
+			if stop := bytes.IndexAny(value, `"'; />`); stop >= 0 {
+				value = value[:stop]
+			}
+			if len(value) > 0 {
+				return string(value)
+			}
+		}
+	}
+}
+
+// isLikelyEUCKR은 본문이 EUC-KR(CP949) 2바이트 문자로 구성되어 있는지 확인합니다
+// ASCII가 아닌 바이트가 모두 올바른 CP949 문자 쌍을 이루면 EUC-KR로 판단합니다
+func isLikelyEUCKR(body []byte) bool {
+	pairs := 0
+	for i := 0; i < len(body); i++ {
+		b := body[i]
+		if b < 0x80 {
+			continue
+		}
+		// 첫 바이트 0x81~0xFE, 둘째 바이트 0x41~0x5A, 0x61~0x7A, 0x81~0xFE (CP949 확장 포함)
+		if b == 0x80 || b == 0xFF {
+			return false
+		}
+		if i+1 >= len(body) {
+			// 판별 구간 끝에서 잘린 문자는 무시
+			break
+		}
+		trail := body[i+1]
+		if !(trail >= 0x41 && trail <= 0x5A) && !(trail >= 0x61 && trail <= 0x7A) && !(trail >= 0x81 && trail <= 0xFE) {
+			return false
+		}
+		pairs++
+		i++
+	}
+	return pairs > 0
+}
@@ -0,0 +1,93 @@
+package crawler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+)
+
+func TestDecodeHTML(t *testing.T) {
+	tests := []struct {
+		name        string
+		fixture     string
+		contentType string
+		wantCharset string
+		wantText    string
+	}{
+		{"meta 선언", "charset/euckr_meta.html", "text/html", "euc-kr", "똠양꿍 국물이 진하고 맛있었어요."},
+		// cp949_utf8_valid.html은 UTF-8로도 올바른 바이트라 헤더 선언이 없으면 UTF-8로 판별됨
+		{"헤더 선언 (CP949)", "charset/cp949_utf8_valid.html", "text/html; charset=CP949", "euc-kr", "홍천 치킨"},
+		{"헤더 선언 (MS949)", "charset/cp949_utf8_valid.html", "text/html;charset=ms949", "euc-kr", "홍천 치킨"},
+		{"헤더 선언 없음", "charset/cp949_utf8_valid.html", "text/html", "utf-8", "ȫõ ġŲ"},
+		{"바이트 판별", "charset/cp949_undeclared.html", "", "euc-kr", "똠방각하 뷁"},
+		{"UTF-8", "generic/brunch.html", "text/html", "utf-8", ""},
+	}
+
+	for _, tt := range tests {
+		raw, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+		if err != nil {
+			t.Fatalf("픽스처 읽기 실패: %v", err)
+		}
+
+		decoded, charset, err := decodeHTML(raw, tt.contentType)
+		if err != nil {
+			t.Errorf("%s: decodeHTML 실패: %v", tt.name, err)
+			continue
+		}
+		if charset != tt.wantCharset {
+			t.Errorf("%s: 문자셋 = %q, want %q", tt.name, charset, tt.wantCharset)
+		}
+		if !strings.Contains(string(decoded), tt.wantText) {
+			t.Errorf("%s: 변환 결과에 %q가 없습니다", tt.name, tt.wantText)
+		}
+	}
+}
+
+func TestIsLikelyEUCKR(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+		want bool
+	}{
+		{"EUC-KR", []byte{'a', 0xC7, 0xD1, 0xB1, 0xDB}, true},
+		{"끝에서 잘린 문자", []byte{0xC7, 0xD1, 0xB1}, true},
+		{"ASCII", []byte("hello"), false},
+		{"잘못된 둘째 바이트", []byte{0xC7, 0x20}, false},
+		{"Latin-1", []byte{'c', 'a', 'f', 0xE9, ' '}, false},
+	}
+
+	for _, tt := range tests {
+		if got := isLikelyEUCKR(tt.body); got != tt.want {
+			t.Errorf("isLikelyEUCKR(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFetchHTMLDecodesEUCKR(t *testing.T) {
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+
+	raw, err := os.ReadFile(filepath.Join("testdata", "charset", "cp949_undeclared.html"))
+	if err != nil {
+		t.Fatalf("픽스처 읽기 실패: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=euc-kr")
+		w.Write(raw)
+	}))
+	defer server.Close()
+
+	doc, err := fetchHTML(server.URL)
+	if err != nil {
+		t.Fatalf("fetchHTML 실패: %v", err)
+	}
+	if title := doc.Find("title").Text(); title != "선언 없는 페이지" {
+		t.Errorf("제목 = %q, want %q", title, "선언 없는 페이지")
+	}
+}
@@ -0,0 +1,156 @@
+package crawler
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/sh5080/ndns-go/pkg/configs"
+	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+	"github.com/sh5080/ndns-go/pkg/utils"
+)
+
+// Registry는 플랫폼 어댑터 목록을 관리합니다
+// 어댑터는 등록된 순서대로 URL 일치 여부를 확인합니다
+type Registry struct {
+	adapters []_interface.PlatformAdapter
+	lock     sync.RWMutex
+}
+
+// NewRegistry는 새 플랫폼 어댑터 레지스트리를 생성합니다
+func NewRegistry(adapters ..._interface.PlatformAdapter) *Registry {
+	return &Registry{
+		adapters: adapters,
+	}
+}
+
+// DefaultAdapters는 기본으로 지원하는 플랫폼 어댑터 목록을 반환합니다
+func DefaultAdapters() []_interface.PlatformAdapter {
+	return []_interface.PlatformAdapter{
+		NewNaverBlogAdapter(),
+		NewNaverCafeAdapter(),
+		NewNaverPostAdapter(),
+		NewTistoryAdapter(),
+	}
+}
+
+// Register는 레지스트리에 플랫폼 어댑터를 추가합니다
+func (r *Registry) Register(adapter _interface.PlatformAdapter) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.adapters = append(r.adapters, adapter)
+}
+
+// Find는 URL을 처리할 수 있는 첫 번째 플랫폼 어댑터를 반환합니다
+func (r *Registry) Find(url string) _interface.PlatformAdapter {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for _, adapter := range r.adapters {
+		if adapter.Match(url) {
+			return adapter
+		}
+	}
+	return nil
+}
+
+// FindByDocument는 문서 마크업으로 플랫폼을 판별할 수 있는 첫 번째 어댑터를 반환합니다
+func (r *Registry) FindByDocument(doc *goquery.Document) _interface.PlatformAdapter {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for _, adapter := range r.adapters {
+		if matcher, ok := adapter.(_interface.DocumentMatcher); ok && matcher.MatchDocument(doc) {
+			return adapter
+		}
+	}
+	return nil
+}
+
+// CrawlerImpl는 크롤러 서비스 구현체입니다
+type CrawlerImpl struct {
+	_interface.Service
+	registry *Registry
+	fallback _interface.PlatformAdapter // 지원하지 않는 플랫폼에 사용하는 범용 어댑터
+}
+
+// NewCrawlerService는 새 크롤러 서비스를 생성합니다
+// 어댑터를 지정하지 않으면 기본 어댑터 목록을 사용합니다
+func NewCrawlerService(adapters ..._interface.PlatformAdapter) _interface.CrawlerService {
+	if len(adapters) == 0 {
+		adapters = DefaultAdapters()
+	}
+
+	config := configs.GetConfig()
+	configurePageCache(config)
+
+	return &CrawlerImpl{
+		Service: _interface.Service{
+			Config: config,
+		},
+		registry: NewRegistry(adapters...),
+		fallback: NewGenericAdapter(),
+	}
+}
+
+// CrawlBlogPost는 블로그 포스트 URL에서 콘텐츠를 크롤링합니다
+// is2025OrLater가 true일 경우 마지막 데이터는 가져오지 않습니다.
+func (c *CrawlerImpl) CrawlBlogPost(url string, is2025OrLater bool) (*structure.CrawlResult, error) {
+	if url == "" {
+		return nil, fmt.Errorf("URL이 비어 있습니다")
+	}
+
+	// URL 정규화
+	url = normalizeURL(url)
+	utils.DebugLog("크롤링 시작: %s (2025년 이후 포스트: %v)\n", url, is2025OrLater)
+
+	// 결과 초기화
+	result := &structure.CrawlResult{
+		URL: url,
+	}
+
+	// URL을 처리할 플랫폼 어댑터 찾기
+	var doc *goquery.Document
+	adapter := c.registry.Find(url)
+	if adapter != nil {
+		fetchedDoc, err := adapter.Fetch(url)
+		if err != nil {
+			return nil, err
+		}
+		doc = fetchedDoc
+	} else {
+		// URL로 판별할 수 없는 경우 (커스텀 도메인 등) 문서 마크업으로 판별
+		fetchedDoc, err := fetchHTML(url)
+		if err != nil {
+			return nil, fmt.Errorf("페이지 가져오기 실패: %w", err)
+		}
+
+		adapter = c.registry.FindByDocument(fetchedDoc)
+		if adapter == nil {
+			// 지원하지 않는 플랫폼은 범용 추출기로 본문 추정
+			adapter = c.fallback
+		}
+		doc = fetchedDoc
+	}
+	utils.DebugLog("플랫폼 어댑터 선택: %s\n", adapter.Name())
+
+	// 어댑터가 신뢰도를 낮추지 않으면 전용 어댑터 신뢰도 사용
+	result.Extractor = adapter.Name()
+	result.Trust = constants.EXTRACTOR_TRUST_PLATFORM
+	adapter.Parse(doc, result, is2025OrLater)
+
+	// 댓글이 있는 포스트는 댓글 목록도 가져옴 (실패해도 본문 결과는 사용)
+	if fetcher, ok := adapter.(_interface.CommentFetcher); ok && result.Metadata.CommentCount > 0 {
+		comments, err := fetcher.FetchComments(doc, url)
+		if err != nil {
+			fmt.Printf("댓글 가져오기 실패 (무시됨): %v\n", err)
+		} else {
+			result.Comments = comments
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,137 @@
+package crawler
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// editorParser는 에디터 세대별 본문 파서입니다
+type editorParser func(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool)
+
+// editorParsers는 에디터 세대별 파서 목록입니다
+var editorParsers = map[structure.EditorVersion]editorParser{
+	structure.EditorSmartEditorOne: parseSmartEditorOne,
+	structure.EditorSmartEditor2:   parseSmartEditor2,
+	structure.EditorSmartEditor1:   parseSmartEditor1,
+	structure.EditorMobile:         parseMobileEditor,
+}
+
+// 에디터 세대별 본문 영역 선택자
+var (
+	smartEditorOneSelectors = []string{".se-main-container"}
+	smartEditor2Selectors   = []string{".se_component_wrap"}
+	smartEditor1Selectors   = []string{"#postViewArea"}
+	mobileEditorSelectors   = []string{".post_ct", "#viewTypeSelector", ".sect_dsc"}
+)
+
+// detectEditorVersion은 본문 마크업으로 포스트를 작성한 에디터 세대를 판별합니다
+func detectEditorVersion(doc *goquery.Document) structure.EditorVersion {
+	switch {
+	case hasMarkup(doc, smartEditorOneSelectors):
+		return structure.EditorSmartEditorOne
+	case hasMarkup(doc, smartEditor2Selectors):
+		return structure.EditorSmartEditor2
+	case hasMarkup(doc, smartEditor1Selectors):
+		return structure.EditorSmartEditor1
+	case hasMarkup(doc, mobileEditorSelectors):
+		return structure.EditorMobile
+	}
+	return structure.EditorUnknown
+}
+
+// hasMarkup은 문서 또는 문서의 루트 요소가 선택자 중 하나와 일치하는지 확인합니다
+// scopeDocument로 만든 문서는 루트 요소가 본문 영역이므로 루트도 함께 확인합니다
+func hasMarkup(doc *goquery.Document, selectors []string) bool {
+	for _, selector := range selectors {
+		if doc.Selection.Is(selector) || doc.Find(selector).Length() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// parseNaverDocument는 에디터 세대를 판별하여 해당 세대의 파서로 네이버 본문을 파싱합니다
+func parseNaverDocument(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	version := detectEditorVersion(doc)
+	result.EditorVersion = version
+
+	parser, exists := editorParsers[version]
+	if !exists {
+		// 에디터를 판별하지 못한 경우 기존 방식으로 문서 전체를 파싱
+		if is2025OrLater {
+			parseNaverBlogFirst(doc, result)
+		} else {
+			parseNaverBlogFull(doc, result)
+		}
+		return
+	}
+	parser(doc, result, is2025OrLater)
+}
+
+// editorContentArea는 문서에서 본문 영역을 찾습니다
+// 문서의 루트 요소가 본문 영역이면 루트를 반환합니다
+func editorContentArea(doc *goquery.Document, selectors []string) *goquery.Selection {
+	for _, selector := range selectors {
+		if doc.Selection.Is(selector) {
+			return doc.Selection
+		}
+		if selected := doc.Find(selector).First(); selected.Length() > 0 {
+			return selected
+		}
+	}
+	return nil
+}
+
+// applyBlockSummary는 본문 블록에서 첫/마지막 문단, 이미지, 스티커를 설정합니다
+// 2025년 이후 포스트는 앞쪽 정보만 설정합니다
+func applyBlockSummary(result *structure.CrawlResult, is2025OrLater bool) {
+	var paragraphs, imageURLs, stickerURLs []string
+	for _, block := range result.Blocks {
+		switch block.Type {
+		case structure.BlockTypeText, structure.BlockTypeQuote:
+			if len(block.Text) > 5 {
+				paragraphs = append(paragraphs, block.Text)
+			}
+		case structure.BlockTypeImage:
+			imageURLs = append(imageURLs, block.ImageURL)
+		case structure.BlockTypeSticker:
+			stickerURLs = append(stickerURLs, block.ImageURL)
+		}
+	}
+
+	// 문단 설정
+	if len(paragraphs) > 0 {
+		if is2025OrLater {
+			result.FirstParagraph = strings.Join(paragraphs[:min(len(paragraphs), 10)], " ")
+		} else {
+			result.FirstParagraph = strings.Join(paragraphs[:min(len(paragraphs), 3)], " ")
+			if len(paragraphs) > 1 {
+				result.LastParagraph = strings.Join(extractLastParagraphs(paragraphs, 3), " ")
+			} else {
+				result.LastParagraph = result.FirstParagraph
+			}
+		}
+	}
+
+	// 이미지 설정
+	if len(imageURLs) > 0 {
+		result.FirstImageURL = imageURLs[0]
+		if !is2025OrLater {
+			result.LastImageURL = imageURLs[len(imageURLs)-1]
+		}
+	}
+
+	// 스티커 설정
+	if len(stickerURLs) > 0 {
+		result.FirstStickerURL = stickerURLs[0]
+		if len(stickerURLs) > 1 {
+			result.SecondStickerURL = stickerURLs[1]
+			if !is2025OrLater {
+				result.LastStickerURL = stickerURLs[len(stickerURLs)-1]
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package crawler
+
+import (
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// parseMobileEditor는 구버전 모바일 페이지(.post_ct) 본문을 파싱합니다
+// 모바일 본문은 스마트에디터 1.0과 같은 HTML 구조이며, 이미지는 지연 로딩 속성(data-lazy-src)을 사용합니다
+func parseMobileEditor(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	contentArea := editorContentArea(doc, mobileEditorSelectors)
+	if contentArea == nil {
+		return
+	}
+
+	setBlocks(result, legacyBlocks(contentArea))
+	applyBlockSummary(result, is2025OrLater)
+}
@@ -0,0 +1,32 @@
+package crawler
+
+import (
+	"testing"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestParseMobileEditor(t *testing.T) {
+	wantTypes := []structure.BlockType{
+		structure.BlockTypeText,
+		structure.BlockTypeImage,
+		structure.BlockTypeText,
+		structure.BlockTypeText,
+		structure.BlockTypeSticker,
+		structure.BlockTypeText,
+	}
+
+	assertEditorParse(t, parseMobileEditor, "naver/mobile.html", false, structure.CrawlResult{
+		FirstParagraph:  "해운대 해수욕장 앞 호텔에서 1박 했습니다. 오션뷰 객실이라 아침 풍경이 좋았어요. 조식은 평범했습니다.",
+		LastParagraph:   "오션뷰 객실이라 아침 풍경이 좋았어요. 조식은 평범했습니다. 본 포스팅은 호텔로부터 숙박을 제공받아 작성되었습니다.",
+		FirstImageURL:   "https://mblogthumb-phinf.pstatic.net/20140620_1/room.jpg?type=w2",
+		LastImageURL:    "https://mblogthumb-phinf.pstatic.net/20140620_1/room.jpg?type=w2",
+		FirstStickerURL: "https://storep-phinf.pstatic.net/moon_james/original_5.png",
+	}, wantTypes)
+
+	assertEditorParse(t, parseMobileEditor, "naver/mobile.html", true, structure.CrawlResult{
+		FirstParagraph:  "해운대 해수욕장 앞 호텔에서 1박 했습니다. 오션뷰 객실이라 아침 풍경이 좋았어요. 조식은 평범했습니다. 본 포스팅은 호텔로부터 숙박을 제공받아 작성되었습니다.",
+		FirstImageURL:   "https://mblogthumb-phinf.pstatic.net/20140620_1/room.jpg?type=w2",
+		FirstStickerURL: "https://storep-phinf.pstatic.net/moon_james/original_5.png",
+	}, wantTypes)
+}
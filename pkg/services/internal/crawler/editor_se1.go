@@ -0,0 +1,122 @@
+package crawler
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// parseSmartEditor1은 스마트에디터 1.0(#postViewArea) 본문을 파싱합니다
+// 본문은 문단 태그 없이 div, font, br로 작성된 경우가 많아 요소 경계와 줄바꿈으로 문단을 나눕니다
+func parseSmartEditor1(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	contentArea := editorContentArea(doc, smartEditor1Selectors)
+	if contentArea == nil {
+		return
+	}
+
+	setBlocks(result, legacyBlocks(contentArea))
+	applyBlockSummary(result, is2025OrLater)
+}
+
+// legacyBlocks는 에디터 구조 없이 작성된 HTML 본문을 블록으로 변환합니다
+func legacyBlocks(contentArea *goquery.Selection) []structure.ContentBlock {
+	walker := &legacyBlockWalker{area: contentArea}
+	for _, node := range contentArea.Nodes {
+		walker.walk(node)
+	}
+	walker.flush()
+	return walker.blocks
+}
+
+// legacyBlockWalker는 HTML 노드를 순서대로 방문하며 블록을 만듭니다
+type legacyBlockWalker struct {
+	area   *goquery.Selection
+	blocks []structure.ContentBlock
+	text   strings.Builder
+}
+
+// 문단 경계가 되는 요소
+var legacyBreakElements = map[string]bool{
+	"p": true, "div": true, "li": true, "ul": true, "ol": true, "table": true, "tr": true, "td": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "center": true,
+}
+
+// walk는 노드의 자식을 순서대로 방문합니다
+func (w *legacyBlockWalker) walk(node *html.Node) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		switch child.Type {
+		case html.TextNode:
+			w.text.WriteString(child.Data)
+		case html.ElementNode:
+			w.visitElement(child)
+		}
+	}
+}
+
+// visitElement는 요소 종류에 따라 블록을 추가하거나 자식을 방문합니다
+func (w *legacyBlockWalker) visitElement(node *html.Node) {
+	switch node.Data {
+	case "script", "style", "noscript":
+		return
+
+	case "br":
+		w.flush()
+
+	case "hr":
+		w.flush()
+		w.blocks = append(w.blocks, structure.ContentBlock{Type: structure.BlockTypeHR})
+
+	case "img":
+		w.flush()
+		img := w.area.FindNodes(node)
+		imgURL := imageSource(img)
+		if imgURL == "" {
+			return
+		}
+		if isStickerImage(img, imgURL) {
+			w.blocks = append(w.blocks, structure.ContentBlock{Type: structure.BlockTypeSticker, ImageURL: imgURL})
+		} else if !isExcludedImage(imgURL) {
+			w.blocks = append(w.blocks, structure.ContentBlock{Type: structure.BlockTypeImage, ImageURL: imgURL})
+		}
+
+	case "blockquote":
+		w.flush()
+		if text := cleanText(w.area.FindNodes(node).Text()); text != "" {
+			w.blocks = append(w.blocks, structure.ContentBlock{Type: structure.BlockTypeQuote, Text: text})
+		}
+
+	case "iframe":
+		w.flush()
+		src := w.area.FindNodes(node).AttrOr("src", "")
+		if src == "" {
+			return
+		}
+		blockType := structure.BlockTypeVideo
+		if strings.Contains(src, "map") {
+			blockType = structure.BlockTypeMap
+		}
+		w.blocks = append(w.blocks, structure.ContentBlock{Type: blockType, LinkURL: src})
+
+	default:
+		if legacyBreakElements[node.Data] {
+			w.flush()
+			w.walk(node)
+			w.flush()
+			return
+		}
+		// span, font, a 등 인라인 요소는 현재 문단에 이어서 수집
+		w.walk(node)
+	}
+}
+
+// flush는 모아둔 텍스트를 문단 블록으로 추가합니다
+func (w *legacyBlockWalker) flush() {
+	text := cleanText(w.text.String())
+	w.text.Reset()
+	if text != "" {
+		w.blocks = append(w.blocks, structure.ContentBlock{Type: structure.BlockTypeText, Text: text})
+	}
+}
@@ -0,0 +1,49 @@
+package crawler
+
+import (
+	"strings"
+	"testing"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestParseSmartEditor1(t *testing.T) {
+	wantTypes := []structure.BlockType{
+		structure.BlockTypeText,
+		structure.BlockTypeText,
+		structure.BlockTypeImage,
+		structure.BlockTypeText,
+		structure.BlockTypeQuote,
+		structure.BlockTypeSticker,
+		structure.BlockTypeText,
+		structure.BlockTypeText,
+		structure.BlockTypeHR,
+		structure.BlockTypeSticker,
+	}
+
+	assertEditorParse(t, parseSmartEditor1, "naver/se1.html", false, structure.CrawlResult{
+		FirstParagraph:   "홍대입구역 근처 파스타 가게에 다녀왔습니다. 크림 파스타가 유명한 곳이에요. 소스가 진하고 면이 꼬들꼬들했어요.",
+		LastParagraph:    "주말 저녁에는 예약 필수입니다. 가격은 1인 15,000원 정도입니다. 내돈내산 솔직 후기였습니다.",
+		FirstImageURL:    "https://postfiles.pstatic.net/20120315_1/pasta.jpg?type=w2",
+		LastImageURL:     "https://postfiles.pstatic.net/20120315_1/pasta.jpg?type=w2",
+		FirstStickerURL:  "https://storep-phinf.pstatic.net/sticker_cony/original_3.gif",
+		SecondStickerURL: "https://storep-phinf.pstatic.net/sticker_cony/original_9.gif",
+		LastStickerURL:   "https://storep-phinf.pstatic.net/sticker_cony/original_9.gif",
+	}, wantTypes)
+}
+
+func TestParseSmartEditor1ExcludesWidgets(t *testing.T) {
+	doc := loadFixture(t, "naver/se1.html")
+	got := structure.CrawlResult{}
+	parseSmartEditor1(doc, &got, false)
+
+	// 본문 밖의 카테고리, 위젯, 공감 영역은 포함하지 않음
+	for _, text := range []string{"전체보기", "방문자 위젯", "공감"} {
+		if strings.Contains(got.Content, text) {
+			t.Errorf("본문 텍스트에 %q가 포함되었습니다: %s", text, got.Content)
+		}
+	}
+	if sticker := got.FindNearEnd(structure.BlockTypeSticker, 0); sticker == nil {
+		t.Error("마지막 블록의 스티커를 찾지 못했습니다")
+	}
+}
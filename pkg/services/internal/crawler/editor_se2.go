@@ -0,0 +1,92 @@
+package crawler
+
+import (
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// parseSmartEditor2는 스마트에디터 2.0(.se_component_wrap) 본문을 파싱합니다
+// 본문은 .se_component 단위로 구성되며, 컴포넌트 클래스로 블록 종류를 구분합니다
+func parseSmartEditor2(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	contentArea := editorContentArea(doc, smartEditor2Selectors)
+	if contentArea == nil {
+		return
+	}
+
+	var blocks []structure.ContentBlock
+	contentArea.Find(".se_component").Each(func(i int, component *goquery.Selection) {
+		blocks = append(blocks, smartEditor2Blocks(component)...)
+	})
+
+	setBlocks(result, blocks)
+	applyBlockSummary(result, is2025OrLater)
+}
+
+// smartEditor2Blocks는 스마트에디터 2.0 컴포넌트를 블록으로 변환합니다
+func smartEditor2Blocks(component *goquery.Selection) []structure.ContentBlock {
+	var blocks []structure.ContentBlock
+
+	switch {
+	case component.HasClass("se_documentTitle"):
+		// 제목은 본문에 포함하지 않음
+
+	case component.HasClass("se_quotation"):
+		if text := cleanText(component.Find(".se_textarea").Text()); text != "" {
+			blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeQuote, Text: text})
+		}
+
+	case component.HasClass("se_sticker"):
+		if imgURL := imageSource(component.Find("img").First()); imgURL != "" {
+			blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeSticker, ImageURL: imgURL})
+		}
+
+	case component.HasClass("se_image"), component.HasClass("se_imageStrip"):
+		caption := cleanText(component.Find(".se_caption").Text())
+		component.Find("img").Each(func(i int, img *goquery.Selection) {
+			imgURL := imageSource(img)
+			if imgURL == "" || isExcludedImage(imgURL) {
+				return
+			}
+			blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeImage, ImageURL: imgURL, Caption: caption})
+		})
+
+	case component.HasClass("se_oglink"):
+		blocks = append(blocks, structure.ContentBlock{
+			Type:     structure.BlockTypeLinkCard,
+			Text:     cleanText(component.Find(".se_og_tit").Text()),
+			ImageURL: imageSource(component.Find(".se_og_thumb img").First()),
+			LinkURL:  component.Find("a[href]").First().AttrOr("href", ""),
+		})
+
+	case component.HasClass("se_map"):
+		blocks = append(blocks, structure.ContentBlock{
+			Type:    structure.BlockTypeMap,
+			Text:    cleanText(component.Find(".se_map_title, .se_title").First().Text()),
+			LinkURL: component.Find("a[href]").First().AttrOr("href", ""),
+		})
+
+	case component.HasClass("se_video"), component.HasClass("se_oembed"):
+		blocks = append(blocks, structure.ContentBlock{
+			Type:    structure.BlockTypeVideo,
+			LinkURL: component.Find("iframe").First().AttrOr("src", ""),
+		})
+
+	case component.HasClass("se_horizontalLine"):
+		blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeHR})
+
+	default:
+		// 문단 컴포넌트 (se_paragraph, se_sectionTitle 등)
+		paragraphs := component.Find(".se_textarea p")
+		if paragraphs.Length() == 0 {
+			paragraphs = component.Find(".se_textarea")
+		}
+		paragraphs.Each(func(i int, p *goquery.Selection) {
+			if text := cleanText(p.Text()); text != "" {
+				blocks = append(blocks, structure.ContentBlock{Type: structure.BlockTypeText, Text: text})
+			}
+		})
+	}
+
+	return blocks
+}
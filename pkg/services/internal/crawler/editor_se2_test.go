@@ -0,0 +1,56 @@
+package crawler
+
+import (
+	"testing"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestParseSmartEditor2(t *testing.T) {
+	wantTypes := []structure.BlockType{
+		structure.BlockTypeText,
+		structure.BlockTypeText,
+		structure.BlockTypeImage,
+		structure.BlockTypeSticker,
+		structure.BlockTypeQuote,
+		structure.BlockTypeLinkCard,
+		structure.BlockTypeHR,
+		structure.BlockTypeText,
+		structure.BlockTypeImage,
+		structure.BlockTypeSticker,
+	}
+
+	assertEditorParse(t, parseSmartEditor2, "naver/se2.html", false, structure.CrawlResult{
+		FirstParagraph:   "애월 해안도로를 따라 카페 세 곳을 다녀왔어요. 첫 번째는 바다가 바로 보이는 통창 카페입니다. 음료는 모두 직접 구매했습니다.",
+		LastParagraph:    "첫 번째는 바다가 바로 보이는 통창 카페입니다. 음료는 모두 직접 구매했습니다. 마지막 카페는 디저트가 맛있었습니다.",
+		FirstImageURL:    "https://postfiles.pstatic.net/20190801_1/cafe1.jpg?type=w966",
+		LastImageURL:     "https://postfiles.pstatic.net/20190801_2/cake.jpg?type=w966",
+		FirstStickerURL:  "https://storep-phinf.pstatic.net/linegrey/original_12.gif",
+		SecondStickerURL: "https://storep-phinf.pstatic.net/linegrey/original_20.gif",
+		LastStickerURL:   "https://storep-phinf.pstatic.net/linegrey/original_20.gif",
+	}, wantTypes)
+
+	assertEditorParse(t, parseSmartEditor2, "naver/se2.html", true, structure.CrawlResult{
+		FirstParagraph:   "애월 해안도로를 따라 카페 세 곳을 다녀왔어요. 첫 번째는 바다가 바로 보이는 통창 카페입니다. 음료는 모두 직접 구매했습니다. 마지막 카페는 디저트가 맛있었습니다.",
+		FirstImageURL:    "https://postfiles.pstatic.net/20190801_1/cafe1.jpg?type=w966",
+		FirstStickerURL:  "https://storep-phinf.pstatic.net/linegrey/original_12.gif",
+		SecondStickerURL: "https://storep-phinf.pstatic.net/linegrey/original_20.gif",
+	}, wantTypes)
+}
+
+func TestParseSmartEditor2ExcludesSidebar(t *testing.T) {
+	doc := loadFixture(t, "naver/se2.html")
+	got := structure.CrawlResult{}
+	parseSmartEditor2(doc, &got, false)
+
+	for _, block := range got.Blocks {
+		if block.Text == "카테고리 전체보기 (312)" || block.ImageURL == "https://blogpfthumb-phinf.pstatic.net/profile.jpg" {
+			t.Errorf("본문 밖의 요소가 블록에 포함되었습니다: %+v", block)
+		}
+	}
+
+	card := got.BlocksOfType(structure.BlockTypeLinkCard)
+	if len(card) != 1 || card[0].LinkURL != "https://www.instagram.com/aewol_cafe" {
+		t.Errorf("링크 카드 블록 = %+v", card)
+	}
+}
@@ -0,0 +1,19 @@
+package crawler
+
+import (
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// parseSmartEditorOne은 스마트에디터 ONE(.se-main-container) 본문을 파싱합니다
+// 본문은 .se-component 단위로 구성되며, 제목과 공감/댓글 영역 등 본문 밖의 요소는 제외합니다
+func parseSmartEditorOne(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	contentArea := editorContentArea(doc, smartEditorOneSelectors)
+	if contentArea == nil {
+		return
+	}
+
+	setContentBlocks(result, contentArea)
+	applyBlockSummary(result, is2025OrLater)
+}
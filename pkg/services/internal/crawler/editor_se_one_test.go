@@ -0,0 +1,50 @@
+package crawler
+
+import (
+	"testing"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestParseSmartEditorOne(t *testing.T) {
+	wantTypes := []structure.BlockType{
+		structure.BlockTypeText,
+		structure.BlockTypeText,
+		structure.BlockTypeImage,
+		structure.BlockTypeQuote,
+		structure.BlockTypeMap,
+		structure.BlockTypeHR,
+		structure.BlockTypeText,
+		structure.BlockTypeSticker,
+	}
+
+	assertEditorParse(t, parseSmartEditorOne, "naver/se_one.html", false, structure.CrawlResult{
+		FirstParagraph:  "을지로 골목 안쪽에 있는 40년 된 노포에 다녀왔어요. 점심시간에는 줄이 길어서 11시 반에 도착했습니다. 수육이 정말 부드럽고 잡내가 없었어요.",
+		LastParagraph:   "점심시간에는 줄이 길어서 11시 반에 도착했습니다. 수육이 정말 부드럽고 잡내가 없었어요. 가격은 조금 있지만 재방문 의사 있습니다.",
+		FirstImageURL:   "https://postfiles.pstatic.net/MjAyNTAx/suyuk.jpg?type=w773",
+		LastImageURL:    "https://postfiles.pstatic.net/MjAyNTAx/suyuk.jpg?type=w773",
+		FirstStickerURL: "https://storep-phinf.pstatic.net/ogq_5c8e8a2c5b1e3/original_7.png?type=p100_100",
+	}, wantTypes)
+
+	assertEditorParse(t, parseSmartEditorOne, "naver/se_one.html", true, structure.CrawlResult{
+		FirstParagraph:  "을지로 골목 안쪽에 있는 40년 된 노포에 다녀왔어요. 점심시간에는 줄이 길어서 11시 반에 도착했습니다. 수육이 정말 부드럽고 잡내가 없었어요. 가격은 조금 있지만 재방문 의사 있습니다.",
+		FirstImageURL:   "https://postfiles.pstatic.net/MjAyNTAx/suyuk.jpg?type=w773",
+		FirstStickerURL: "https://storep-phinf.pstatic.net/ogq_5c8e8a2c5b1e3/original_7.png?type=p100_100",
+	}, wantTypes)
+}
+
+func TestParseSmartEditorOneBlockDetails(t *testing.T) {
+	doc := loadFixture(t, "naver/se_one.html")
+	got := structure.CrawlResult{}
+	parseSmartEditorOne(doc, &got, false)
+
+	image := got.BlocksOfType(structure.BlockTypeImage)
+	if len(image) != 1 || image[0].Caption != "대표 메뉴 수육" {
+		t.Errorf("이미지 캡션 = %+v, want 대표 메뉴 수육", image)
+	}
+
+	place := got.BlocksOfType(structure.BlockTypeMap)
+	if len(place) != 1 || place[0].Text != "을지로 노포집" || place[0].LinkURL != "https://map.naver.com/p/entry/place/11111111" {
+		t.Errorf("지도 블록 = %+v", place)
+	}
+}
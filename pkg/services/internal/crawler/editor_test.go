@@ -0,0 +1,85 @@
+package crawler
+
+import (
+	"reflect"
+	"testing"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// blockTypes는 블록 종류만 순서대로 반환합니다
+func blockTypes(blocks []structure.ContentBlock) []structure.BlockType {
+	types := make([]structure.BlockType, 0, len(blocks))
+	for _, block := range blocks {
+		types = append(types, block.Type)
+	}
+	return types
+}
+
+// assertEditorParse는 에디터 파서의 요약 필드와 블록 종류를 확인합니다
+func assertEditorParse(t *testing.T, parser editorParser, fixture string, is2025OrLater bool, want structure.CrawlResult, wantTypes []structure.BlockType) {
+	t.Helper()
+
+	doc := loadFixture(t, fixture)
+	got := structure.CrawlResult{}
+	parser(doc, &got, is2025OrLater)
+
+	if types := blockTypes(got.Blocks); !reflect.DeepEqual(types, wantTypes) {
+		t.Errorf("%s 블록 종류\n got: %v\nwant: %v", fixture, types, wantTypes)
+	}
+
+	got.Blocks = nil
+	got.Content = ""
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s (is2025OrLater=%v)\n got: %+v\nwant: %+v", fixture, is2025OrLater, got, want)
+	}
+}
+
+func TestDetectEditorVersion(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    structure.EditorVersion
+	}{
+		{"naver/se_one.html", structure.EditorSmartEditorOne},
+		{"naver/se2.html", structure.EditorSmartEditor2},
+		{"naver/se1.html", structure.EditorSmartEditor1},
+		{"naver/mobile.html", structure.EditorMobile},
+		{"tistory/wordpress.html", structure.EditorUnknown},
+	}
+
+	for _, tt := range tests {
+		doc := loadFixture(t, tt.fixture)
+		if got := detectEditorVersion(doc); got != tt.want {
+			t.Errorf("detectEditorVersion(%s) = %q, want %q", tt.fixture, got, tt.want)
+		}
+	}
+}
+
+func TestDetectEditorVersionScopedDocument(t *testing.T) {
+	// 카페/포스트 어댑터는 본문 영역만 남긴 문서를 넘기므로 루트 요소로도 판별되어야 함
+	doc := scopeDocument(loadFixture(t, "naver/se_one.html"), smartEditorOneSelectors)
+	if got := detectEditorVersion(doc); got != structure.EditorSmartEditorOne {
+		t.Fatalf("detectEditorVersion(scoped) = %q, want %q", got, structure.EditorSmartEditorOne)
+	}
+
+	got := structure.CrawlResult{}
+	parseNaverDocument(doc, &got, false)
+	if len(got.Blocks) != 8 {
+		t.Errorf("본문 영역 문서의 블록 수 = %d, want 8", len(got.Blocks))
+	}
+}
+
+func TestParseNaverDocumentReportsEditorVersion(t *testing.T) {
+	for _, fixture := range []string{"naver/se_one.html", "naver/se2.html", "naver/se1.html", "naver/mobile.html"} {
+		doc := loadFixture(t, fixture)
+		got := structure.CrawlResult{}
+		parseNaverDocument(doc, &got, false)
+
+		if got.EditorVersion == "" || got.EditorVersion == structure.EditorUnknown {
+			t.Errorf("%s 에디터 버전이 기록되지 않았습니다: %q", fixture, got.EditorVersion)
+		}
+		if len(got.Blocks) == 0 {
+			t.Errorf("%s 블록을 추출하지 못했습니다", fixture)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package crawler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+var (
+	// 본문이 아닐 가능성이 높은 요소의 class/id
+	unlikelyCandidateRegex = regexp.MustCompile(constants.GENERIC_UNLIKELY_PATTERN)
+	// 본문일 가능성이 높은 요소의 class/id
+	positiveCandidateRegex = regexp.MustCompile(constants.GENERIC_POSITIVE_PATTERN)
+)
+
+// 점수 계산에서 제외하는 요소 (본문이 아닌 영역)
+const genericRemoveSelector = "script, style, noscript, nav, header, footer, aside, form, button, select, textarea"
+
+// 문단 점수를 계산하는 요소
+const genericParagraphSelector = "p, pre, td, blockquote, li, div"
+
+// 문단으로 보기 위한 최소 글자 수
+const genericMinParagraphLength = 25
+
+// GenericAdapter는 전용 어댑터가 없는 블로그 페이지에서 본문을 추정하는 범용 어댑터입니다
+// 텍스트 밀도와 링크 밀도로 DOM 요소에 점수를 매겨 본문 영역을 찾습니다 (Readability 방식)
+type GenericAdapter struct{}
+
+// NewGenericAdapter는 새 범용 어댑터를 생성합니다
+func NewGenericAdapter() *GenericAdapter {
+	return &GenericAdapter{}
+}
+
+// Name은 추출기 이름을 반환합니다
+func (a *GenericAdapter) Name() string {
+	return structure.ExtractorGeneric
+}
+
+// Match는 항상 false를 반환합니다
+// 범용 어댑터는 다른 어댑터가 처리하지 못한 페이지에만 사용합니다
+func (a *GenericAdapter) Match(url string) bool {
+	return false
+}
+
+// Fetch는 블로그 페이지를 가져옵니다
+func (a *GenericAdapter) Fetch(url string) (*goquery.Document, error) {
+	doc, err := fetchHTML(url)
+	if err != nil {
+		return nil, fmt.Errorf("페이지 가져오기 실패: %w", err)
+	}
+	return doc, nil
+}
+
+// Parse는 점수가 가장 높은 본문 영역에서 문단, 이미지, 이모티콘을 추출합니다
+// 전용 어댑터보다 정확도가 낮으므로 결과의 신뢰도를 낮게 설정합니다
+func (a *GenericAdapter) Parse(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	result.Trust = constants.EXTRACTOR_TRUST_GENERIC
+
+	contentArea := findMainContent(doc)
+	if contentArea == nil {
+		fmt.Printf("본문 영역을 찾지 못했습니다.\n")
+		return
+	}
+
+	setContentBlocks(result, contentArea)
+	applyBlockSummary(result, is2025OrLater)
+}
+
+// contentCandidate는 본문 후보 요소와 점수입니다
+type contentCandidate struct {
+	selection *goquery.Selection
+	score     float64
+}
+
+// findMainContent는 문서에서 본문일 가능성이 가장 높은 요소를 찾습니다
+func findMainContent(doc *goquery.Document) *goquery.Selection {
+	body := doc.Find("body").First()
+	if body.Length() == 0 {
+		body = doc.Selection
+	}
+
+	// 본문이 아닌 요소 제거
+	body.Find(genericRemoveSelector).Remove()
+	body.Find("*").Each(func(i int, s *goquery.Selection) {
+		if isUnlikelyCandidate(s) {
+			s.Remove()
+		}
+	})
+
+	// 문단 점수를 상위 요소에 누적
+	candidates := make(map[*html.Node]*contentCandidate)
+	var order []*html.Node
+	addScore := func(s *goquery.Selection, score float64) {
+		if s.Length() == 0 || goquery.NodeName(s) == "html" {
+			return
+		}
+		node := s.Nodes[0]
+		candidate, exists := candidates[node]
+		if !exists {
+			candidate = &contentCandidate{selection: s, score: initialCandidateScore(s)}
+			candidates[node] = candidate
+			order = append(order, node)
+		}
+		candidate.score += score
+	}
+
+	body.Find(genericParagraphSelector).Each(func(i int, p *goquery.Selection) {
+		// 블록 요소를 포함한 div는 문단이 아닌 컨테이너로 간주
+		if goquery.NodeName(p) == "div" && p.ChildrenFiltered("p, div, table, ul, ol, blockquote, pre, figure").Length() > 0 {
+			return
+		}
+
+		text := cleanText(p.Text())
+		length := utf8.RuneCountInString(text)
+		if length < genericMinParagraphLength {
+			return
+		}
+
+		// 기본 1점 + 쉼표 수 + 100자마다 1점 (최대 3점)
+		score := 1 + float64(strings.Count(text, ",")) + float64(min(length/100, 3))
+
+		parent := p.Parent()
+		addScore(parent, score)
+		addScore(parent.Parent(), score/2)
+	})
+
+	// 링크 밀도가 높은 후보(목록, 메뉴 등)는 점수를 낮춤
+	var best *contentCandidate
+	for _, node := range order {
+		candidate := candidates[node]
+		candidate.score *= 1 - linkDensity(candidate.selection)
+		if best == nil || candidate.score > best.score {
+			best = candidate
+		}
+	}
+	if best != nil {
+		return best.selection
+	}
+
+	// 점수를 매길 문단이 없으면 시맨틱 태그 사용
+	if semantic := body.Find("article, main, [role='main']").First(); semantic.Length() > 0 {
+		return semantic
+	}
+	return nil
+}
+
+// isUnlikelyCandidate는 class/id로 보아 본문이 아닐 가능성이 높은 요소인지 확인합니다
+func isUnlikelyCandidate(s *goquery.Selection) bool {
+	switch goquery.NodeName(s) {
+	case "body", "article", "main", "a":
+		return false
+	}
+
+	classAndID := s.AttrOr("class", "") + " " + s.AttrOr("id", "")
+	return unlikelyCandidateRegex.MatchString(classAndID) && !positiveCandidateRegex.MatchString(classAndID)
+}
+
+// initialCandidateScore는 태그와 class/id에 따른 후보 요소의 기본 점수를 계산합니다
+func initialCandidateScore(s *goquery.Selection) float64 {
+	score := 0.0
+	switch goquery.NodeName(s) {
+	case "article":
+		score += 10
+	case "div", "section":
+		score += 5
+	case "pre", "td", "blockquote":
+		score += 3
+	case "address", "ol", "ul", "dl", "dd", "dt", "li", "form":
+		score -= 3
+	case "h1", "h2", "h3", "h4", "h5", "h6", "th":
+		score -= 5
+	}
+
+	classAndID := s.AttrOr("class", "") + " " + s.AttrOr("id", "")
+	if positiveCandidateRegex.MatchString(classAndID) {
+		score += 25
+	}
+	if unlikelyCandidateRegex.MatchString(classAndID) {
+		score -= 25
+	}
+	return score
+}
+
+// linkDensity는 요소 텍스트 중 링크 텍스트가 차지하는 비율을 계산합니다
+func linkDensity(s *goquery.Selection) float64 {
+	textLength := utf8.RuneCountInString(cleanText(s.Text()))
+	if textLength == 0 {
+		return 0
+	}
+
+	linkLength := 0
+	s.Find("a").Each(func(i int, a *goquery.Selection) {
+		linkLength += utf8.RuneCountInString(cleanText(a.Text()))
+	})
+	return float64(linkLength) / float64(textLength)
+}
@@ -0,0 +1,75 @@
+package crawler
+
+import (
+	"strings"
+	"testing"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestGenericAdapterParse(t *testing.T) {
+	adapter := NewGenericAdapter()
+
+	tests := []struct {
+		fixture      string
+		wantFirst    string   // FirstParagraph 시작 문장
+		wantImage    string   // FirstImageURL
+		wantSticker  string   // FirstStickerURL
+		excludeTexts []string // 본문에 포함되면 안 되는 텍스트 (메뉴, 사이드바, 댓글 등)
+	}{
+		{
+			fixture:      "generic/brunch.html",
+			wantFirst:    "첫째 주, 적응하기 회사를 그만두고",
+			wantImage:    "https://img1.daumcdn.net/thumb/R1280x0/?fname=http%3A%2F%2Ft1.daumcdn.net%2Fbrunch%2Fservice%2Fuser%2Fabc%2Fimage%2Fhouse.jpg",
+			excludeTexts: []string{"브런치 나우", "좋은 글 잘 읽었습니다", "두 번째 이야기"},
+		},
+		{
+			fixture:      "generic/velog.html",
+			wantFirst:    "들어가며 이번 글에서는 Go와 goquery로",
+			wantImage:    "https://velog.velcdn.com/images/dev/post/flow.png",
+			wantSticker:  "https://velog.velcdn.com/images/dev/post/emoji-smile.png",
+			excludeTexts: []string{"트렌딩", "© velog"},
+		},
+		{
+			fixture:      "generic/wordpress_sidebar.html",
+			wantFirst:    "KTX를 타면 서울에서 강릉까지",
+			wantImage:    "https://travel.example.com/wp-content/uploads/2024/05/anmok.jpg",
+			wantSticker:  "https://s.w.org/images/core/emoji/15.0.3/72x72/1f60a.png",
+			excludeTexts: []string{"협찬 환영합니다", "최근 글", "Powered by WordPress"},
+		},
+	}
+
+	for _, tt := range tests {
+		doc := loadFixture(t, tt.fixture)
+		got := structure.CrawlResult{}
+		adapter.Parse(doc, &got, false)
+
+		if got.Trust != constants.EXTRACTOR_TRUST_GENERIC {
+			t.Errorf("%s Trust = %v, want %v", tt.fixture, got.Trust, constants.EXTRACTOR_TRUST_GENERIC)
+		}
+		if !strings.HasPrefix(got.FirstParagraph, tt.wantFirst) {
+			t.Errorf("%s FirstParagraph = %q, want prefix %q", tt.fixture, got.FirstParagraph, tt.wantFirst)
+		}
+		if got.FirstImageURL != tt.wantImage {
+			t.Errorf("%s FirstImageURL = %q, want %q", tt.fixture, got.FirstImageURL, tt.wantImage)
+		}
+		if got.FirstStickerURL != tt.wantSticker {
+			t.Errorf("%s FirstStickerURL = %q, want %q", tt.fixture, got.FirstStickerURL, tt.wantSticker)
+		}
+		for _, text := range tt.excludeTexts {
+			if strings.Contains(got.Content, text) {
+				t.Errorf("%s 본문에 본문 외 텍스트 %q가 포함되었습니다", tt.fixture, text)
+			}
+		}
+	}
+}
+
+func TestGenericAdapterNeverMatchesURL(t *testing.T) {
+	adapter := NewGenericAdapter()
+	for _, url := range []string{"https://brunch.co.kr/@writer/10", "https://velog.io/@dev/go-crawler"} {
+		if adapter.Match(url) {
+			t.Errorf("범용 어댑터가 URL %q와 일치했습니다", url)
+		}
+	}
+}
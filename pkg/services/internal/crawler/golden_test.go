@@ -0,0 +1,167 @@
+package crawler
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// go test ./pkg/services/internal/crawler -run TestNaverGolden -update
+var update = flag.Bool("update", false, "골든 파일을 현재 추출 결과로 갱신합니다")
+
+// goldenResult는 픽스처 하나에 대해 기대하는 추출 결과입니다
+type goldenResult struct {
+	IframeURL  string                `json:"iframeURL,omitempty"` // extractNaverIframeURL (프레임셋 페이지)
+	Before2025 structure.CrawlResult `json:"before2025"`          // 어댑터 파싱 결과 (2025년 이전 포스트)
+	Since2025  structure.CrawlResult `json:"since2025"`           // 어댑터 파싱 결과 (2025년 이후 포스트)
+	Legacy     structure.CrawlResult `json:"legacy"`              // extractFirstSticker, extractFirstImage, extractCommonParagraphs 결과
+}
+
+// TestNaverGolden은 저장된 네이버 블로그 페이지의 추출 결과를 골든 파일과 비교합니다
+// 네이버 마크업 변경으로 추출 결과가 달라지면 골든 파일과의 차이를 출력합니다
+func TestNaverGolden(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "naver", "*.html"))
+	if err != nil || len(fixtures) == 0 {
+		t.Fatalf("네이버 픽스처를 찾지 못했습니다: %v", err)
+	}
+
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".html")
+		t.Run(name, func(t *testing.T) {
+			got := naverGoldenResult(t, filepath.Join("naver", name+".html"))
+			assertGolden(t, filepath.Join("testdata", "golden", "naver", name+".json"), got)
+		})
+	}
+}
+
+// naverGoldenResult는 픽스처를 어댑터와 기존 추출 함수로 각각 파싱합니다
+func naverGoldenResult(t *testing.T, fixture string) goldenResult {
+	t.Helper()
+
+	adapter := NewNaverBlogAdapter()
+	golden := goldenResult{
+		IframeURL: extractNaverIframeURL(loadFixture(t, fixture), ""),
+	}
+	adapter.Parse(loadFixture(t, fixture), &golden.Before2025, false)
+	adapter.Parse(loadFixture(t, fixture), &golden.Since2025, true)
+	parseNaverBlogFull(loadFixture(t, fixture), &golden.Legacy)
+	return golden
+}
+
+// assertGolden은 결과를 JSON으로 직렬화하여 골든 파일과 비교합니다
+// -update 플래그가 있으면 골든 파일을 새로 씁니다
+func assertGolden(t *testing.T, path string, got any) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatalf("결과 직렬화 실패: %v", err)
+	}
+	data = append(data, '\n')
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("골든 디렉토리 생성 실패: %v", err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatalf("골든 파일 쓰기 실패: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("골든 파일 읽기 실패 (-update 플래그로 생성하세요): %v", err)
+	}
+	if !bytes.Equal(want, data) {
+		t.Errorf("%s 와 추출 결과가 다릅니다 (-: 골든, +: 현재)\n%s", path, lineDiff(string(want), string(data)))
+	}
+}
+
+// lineDiff는 두 텍스트의 줄 단위 차이를 앞뒤 문맥과 함께 반환합니다
+func lineDiff(want, got string) string {
+	const context = 2
+
+	a := strings.Split(strings.TrimSuffix(want, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+
+	// 최장 공통 부분열(LCS) 길이 표
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	// 공통 줄은 ' ', 골든에만 있는 줄은 '-', 현재 결과에만 있는 줄은 '+'
+	type diffLine struct {
+		op   byte
+		text string
+	}
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+
+	// 변경된 줄과 앞뒤 문맥만 출력
+	visible := make([]bool, len(lines))
+	for k, line := range lines {
+		if line.op == ' ' {
+			continue
+		}
+		for c := max(k-context, 0); c <= min(k+context, len(lines)-1); c++ {
+			visible[c] = true
+		}
+	}
+
+	var out strings.Builder
+	skipped := false
+	for k, line := range lines {
+		if !visible[k] {
+			skipped = true
+			continue
+		}
+		if skipped && out.Len() > 0 {
+			out.WriteString("  ...\n")
+		}
+		skipped = false
+		fmt.Fprintf(&out, "%c %s\n", line.op, line.text)
+	}
+	return out.String()
+}
+
+func TestLineDiff(t *testing.T) {
+	want := "{\n  \"a\": 1,\n  \"b\": 2,\n  \"c\": 3,\n  \"d\": 4,\n  \"e\": 5,\n  \"f\": 6\n}\n"
+	got := "{\n  \"a\": 1,\n  \"b\": 2,\n  \"c\": 30,\n  \"d\": 4,\n  \"e\": 5,\n  \"f\": 6\n}\n"
+
+	expected := "    \"a\": 1,\n    \"b\": 2,\n-   \"c\": 3,\n+   \"c\": 30,\n    \"d\": 4,\n    \"e\": 5,\n"
+	if diff := lineDiff(want, got); diff != expected {
+		t.Errorf("lineDiff()\n got:\n%s\nwant:\n%s", diff, expected)
+	}
+}
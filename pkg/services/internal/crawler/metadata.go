@@ -0,0 +1,244 @@
+package crawler
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// 네이버 블로그 시각은 한국 표준시 기준
+var naverTimeZone = time.FixedZone("KST", 9*60*60)
+
+// 메타데이터 영역 선택자 (에디터 세대별 마크업 순서)
+var (
+	metadataCategorySelectors = []string{".blog2_series a.pcol2", ".blog2_series .category a", ".se_series a", ".blog_category a", ".category_name"}
+	metadataTagSelectors      = []string{".wrap_tag .item", ".post_tag .tag", ".tag_area a", "._tagList a"}
+	metadataDateSelectors     = []string{".se_publishDate", ".se-publishDate", ".blog2_container .date", "p.date", ".date.fil5", ".blog_date", ".se_date"}
+	metadataModifiedSelectors = []string{".se_modifyDate", ".se-modifyDate", ".blog_modify_date"}
+	metadataCommentSelectors  = []string{"#commentCount", "._commentCount", ".area_comment .num"}
+	metadataSympathySelectors = []string{".u_likeit_list_btn .u_cnt._count", "._sympathyCount", ".area_sympathy .num"}
+	metadataSeriesSelectors   = []string{".se-module-series", ".area_series", "._seriesArea", ".blog2_series .series"}
+	// "공감 12 · 댓글 3" 형태로 개수를 표시하는 영역
+	metadataSummarySelectors = []string{".area_sympathy", ".post_btn_area", ".wrap_postcomment"}
+)
+
+// 본문에 포함된 JSON 데이터에서 메타데이터를 찾는 정규식
+var (
+	jsonCategoryRegex = regexp.MustCompile(`"categoryName"\s*:\s*("(?:[^"\\]|\\.)*")`)
+	jsonTagsRegex     = regexp.MustCompile(`"tagNames?"\s*:\s*("(?:[^"\\]|\\.)*"|\[[^\]]*\])`)
+	jsonDateRegex     = regexp.MustCompile(`"(?:addDate|publishDate|writtenDate)"\s*:\s*("(?:[^"\\]|\\.)*"|\d+)`)
+	jsonModifiedRegex = regexp.MustCompile(`"(?:updateDate|modifyDate|lastModifiedDate)"\s*:\s*("(?:[^"\\]|\\.)*"|\d+)`)
+	jsonCommentRegex  = regexp.MustCompile(`"(?:commentCount|commentCnt)"\s*:\s*"?(\d+)`)
+	jsonSympathyRegex = regexp.MustCompile(`"(?:sympathyCount|sympathyCnt)"\s*:\s*"?(\d+)`)
+	jsonSeriesRegex   = regexp.MustCompile(`"(?:seriesNo|seriesId)"\s*:\s*"?([1-9]\d*)|"isSeries(?:Post)?"\s*:\s*true`)
+)
+
+// 표시 문구에서 날짜와 개수를 찾는 정규식
+var (
+	publishDateRegex     = regexp.MustCompile(`(\d{4})\s*[./-]\s*(\d{1,2})\s*[./-]\s*(\d{1,2})\.?\s+(\d{1,2}):(\d{2})`)
+	summarySympathyRegex = regexp.MustCompile(`공감\s*([\d,]+)`)
+	summaryCommentRegex  = regexp.MustCompile(`댓글\s*([\d,]+)`)
+)
+
+// extractNaverMetadata는 네이버 포스트 페이지에서 카테고리, 태그, 발행/수정 시각, 댓글/공감 수, 시리즈 여부를 추출합니다
+// 페이지에 포함된 JSON 데이터를 우선 사용하고, 찾지 못한 값은 마크업에서 찾습니다
+func extractNaverMetadata(doc *goquery.Document) structure.PostMetadata {
+	metadata := extractEmbeddedMetadata(doc)
+
+	if metadata.Category == "" {
+		metadata.Category = firstText(doc, metadataCategorySelectors)
+	}
+	if len(metadata.Tags) == 0 {
+		metadata.Tags = markupTags(doc)
+	}
+	if metadata.PublishedAt == nil {
+		metadata.PublishedAt = parsePublishDate(firstText(doc, metadataDateSelectors))
+	}
+	if metadata.ModifiedAt == nil {
+		metadata.ModifiedAt = parsePublishDate(firstText(doc, metadataModifiedSelectors))
+	}
+	if metadata.CommentCount == 0 {
+		metadata.CommentCount = parseCount(firstText(doc, metadataCommentSelectors))
+	}
+	if metadata.SympathyCount == 0 {
+		metadata.SympathyCount = parseCount(firstText(doc, metadataSympathySelectors))
+	}
+	if !metadata.InSeries {
+		metadata.InSeries = hasMarkup(doc, metadataSeriesSelectors)
+	}
+
+	// 개수를 한 줄로 표시하는 구버전/모바일 마크업
+	if metadata.CommentCount == 0 || metadata.SympathyCount == 0 {
+		summary := firstText(doc, metadataSummarySelectors)
+		if metadata.SympathyCount == 0 {
+			metadata.SympathyCount = parseCount(firstSubmatch(summarySympathyRegex, summary))
+		}
+		if metadata.CommentCount == 0 {
+			metadata.CommentCount = parseCount(firstSubmatch(summaryCommentRegex, summary))
+		}
+	}
+
+	return metadata
+}
+
+// extractEmbeddedMetadata는 페이지의 script 태그에 포함된 JSON 데이터에서 메타데이터를 추출합니다
+func extractEmbeddedMetadata(doc *goquery.Document) structure.PostMetadata {
+	var scripts strings.Builder
+	doc.Find("script").Each(func(i int, s *goquery.Selection) {
+		scripts.WriteString(s.Text())
+		scripts.WriteByte('\n')
+	})
+	text := scripts.String()
+
+	var metadata structure.PostMetadata
+	if quoted := firstSubmatch(jsonCategoryRegex, text); quoted != "" {
+		var category string
+		if err := json.Unmarshal([]byte(quoted), &category); err == nil {
+			metadata.Category = strings.TrimSpace(category)
+		}
+	}
+	metadata.Tags = parseEmbeddedTags(firstSubmatch(jsonTagsRegex, text))
+	if value := firstSubmatch(jsonDateRegex, text); value != "" {
+		metadata.PublishedAt = parseEmbeddedDate(value)
+	}
+	if value := firstSubmatch(jsonModifiedRegex, text); value != "" {
+		metadata.ModifiedAt = parseEmbeddedDate(value)
+	}
+	metadata.CommentCount = parseCount(firstSubmatch(jsonCommentRegex, text))
+	metadata.SympathyCount = parseCount(firstSubmatch(jsonSympathyRegex, text))
+	metadata.InSeries = jsonSeriesRegex.MatchString(text)
+	return metadata
+}
+
+// parseEmbeddedTags는 JSON 데이터의 태그를 해석합니다
+// 쉼표로 구분한 문자열("맛집,성수동")과 문자열 배열(["맛집","성수동"])을 모두 처리합니다
+func parseEmbeddedTags(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	var tags []string
+	if strings.HasPrefix(value, "[") {
+		if err := json.Unmarshal([]byte(value), &tags); err != nil {
+			return nil
+		}
+	} else {
+		var joined string
+		if err := json.Unmarshal([]byte(value), &joined); err != nil {
+			return nil
+		}
+		tags = strings.Split(joined, ",")
+	}
+	return normalizeTags(tags)
+}
+
+// markupTags는 태그 영역의 링크 텍스트에서 태그를 추출합니다
+func markupTags(doc *goquery.Document) []string {
+	for _, selector := range metadataTagSelectors {
+		var tags []string
+		doc.Find(selector).Each(func(i int, s *goquery.Selection) {
+			tags = append(tags, s.Text())
+		})
+		if tags = normalizeTags(tags); len(tags) > 0 {
+			return tags
+		}
+	}
+	return nil
+}
+
+// normalizeTags는 태그 앞의 #과 공백을 제거하고, 빈 태그와 중복 태그를 뺍니다 (순서 유지)
+func normalizeTags(tags []string) []string {
+	var normalized []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(tag), "#"))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// parseEmbeddedDate는 JSON 데이터의 발행(수정) 시각을 해석합니다
+// 숫자는 Unix 시각(초 또는 밀리초), 문자열은 표시 형식의 날짜로 처리합니다
+func parseEmbeddedDate(value string) *time.Time {
+	if strings.HasPrefix(value, `"`) {
+		var text string
+		if err := json.Unmarshal([]byte(value), &text); err != nil {
+			return nil
+		}
+		if parsed, err := time.Parse(time.RFC3339, text); err == nil {
+			published := parsed.In(naverTimeZone).Truncate(time.Minute)
+			return &published
+		}
+		return parsePublishDate(text)
+	}
+
+	timestamp, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || timestamp <= 0 {
+		return nil
+	}
+	// 13자리 이상이면 밀리초 단위
+	if timestamp >= 1e12 {
+		timestamp /= 1000
+	}
+	published := time.Unix(timestamp, 0).In(naverTimeZone).Truncate(time.Minute)
+	return &published
+}
+
+// parsePublishDate는 "2024. 3. 15. 14:30" 형태의 표시 문구를 발행 시각으로 해석합니다
+// 시각 없이 날짜만 있거나 "3시간 전"처럼 상대 시각이면 nil을 반환합니다
+func parsePublishDate(text string) *time.Time {
+	match := publishDateRegex.FindStringSubmatch(text)
+	if match == nil {
+		return nil
+	}
+
+	var parts [5]int
+	for i := range parts {
+		parts[i], _ = strconv.Atoi(match[i+1])
+	}
+	if parts[1] < 1 || parts[1] > 12 || parts[2] < 1 || parts[2] > 31 || parts[3] > 23 || parts[4] > 59 {
+		return nil
+	}
+
+	published := time.Date(parts[0], time.Month(parts[1]), parts[2], parts[3], parts[4], 0, 0, naverTimeZone)
+	return &published
+}
+
+// parseCount는 "1,234" 형태의 개수 문구를 숫자로 변환합니다
+// 숫자가 아니면 0을 반환합니다
+func parseCount(text string) int {
+	count, err := strconv.Atoi(strings.ReplaceAll(strings.TrimSpace(text), ",", ""))
+	if err != nil || count < 0 {
+		return 0
+	}
+	return count
+}
+
+// firstText는 선택자 중 처음으로 일치하는 요소의 텍스트를 반환합니다
+func firstText(doc *goquery.Document, selectors []string) string {
+	for _, selector := range selectors {
+		if text := strings.TrimSpace(doc.Find(selector).First().Text()); text != "" {
+			return text
+		}
+	}
+	return ""
+}
+
+// firstSubmatch는 정규식과 처음 일치하는 부분의 첫 번째 그룹을 반환합니다
+func firstSubmatch(regex *regexp.Regexp, text string) string {
+	match := regex.FindStringSubmatch(text)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
@@ -0,0 +1,64 @@
+package crawler
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParsePublishDate(t *testing.T) {
+	want := time.Date(2024, 3, 15, 14, 30, 0, 0, naverTimeZone)
+	tests := []struct {
+		text string
+		want *time.Time
+	}{
+		{"2024. 3. 15. 14:30", &want},
+		{"2024.03.15. 14:30", &want},
+		{"2024/03/15 14:30", &want},
+		{"2024-03-15 14:30", &want},
+		{"2024. 3. 15.", nil},
+		{"3시간 전", nil},
+		{"2024. 13. 15. 14:30", nil},
+	}
+
+	for _, tt := range tests {
+		got := parsePublishDate(tt.text)
+		if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+			t.Errorf("parsePublishDate(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParseEmbeddedDate(t *testing.T) {
+	want := time.Date(2024, 3, 15, 14, 30, 0, 0, naverTimeZone)
+	for _, value := range []string{
+		"1710480600000",
+		"1710480645",
+		`"2024-03-15T05:30:45Z"`,
+		`"2024. 3. 15. 14:30"`,
+	} {
+		got := parseEmbeddedDate(value)
+		if got == nil || !got.Equal(want) {
+			t.Errorf("parseEmbeddedDate(%s) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestParseEmbeddedTags(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{`"맛집,성수동, #브런치"`, []string{"맛집", "성수동", "브런치"}},
+		{`["맛집","성수동","맛집",""]`, []string{"맛집", "성수동"}},
+		{`""`, nil},
+		{`[1, 2]`, nil},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		if got := parseEmbeddedTags(tt.value); !slices.Equal(got, tt.want) {
+			t.Errorf("parseEmbeddedTags(%s) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package crawler
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// NaverBlogAdapter는 네이버 블로그 플랫폼 어댑터입니다
+type NaverBlogAdapter struct{}
+
+// NewNaverBlogAdapter는 새 네이버 블로그 어댑터를 생성합니다
+func NewNaverBlogAdapter() *NaverBlogAdapter {
+	return &NaverBlogAdapter{}
+}
+
+// Name은 플랫폼 이름을 반환합니다
+func (a *NaverBlogAdapter) Name() string {
+	return string(structure.PlatformNaverBlog)
+}
+
+// Match는 네이버 블로그 URL인지 확인합니다
+func (a *NaverBlogAdapter) Match(url string) bool {
+	return strings.Contains(url, "blog.naver.com") || strings.Contains(url, ".blog.me")
+}
+
+// Fetch는 URL에서 blogId/logNo를 추출하여 본문 페이지를 바로 가져옵니다
+// URL을 해석할 수 없는 경우에만 프레임셋 페이지를 거쳐 iframe URL을 찾습니다
+func (a *NaverBlogAdapter) Fetch(url string) (*goquery.Document, error) {
+	blogID, logNo, ok := parseNaverBlogURL(url)
+	if !ok {
+		return fetchNaverFrameset(url)
+	}
+
+	// 1차 시도: PC 본문 페이지 (PostView)
+	doc, err := fetchHTML(naverPostViewURL(blogID, logNo))
+	if err == nil {
+		return doc, nil
+	}
+	// 삭제, 비공개 포스트는 모바일 페이지에서도 볼 수 없음
+	if isUnavailableError(err) {
+		return nil, err
+	}
+	fmt.Printf("PostView 페이지 가져오기 실패, 모바일 페이지로 재시도: %v\n", err)
+
+	// 2차 시도: 모바일 본문 페이지
+	doc, err = fetchHTML(naverMobileURL(blogID, logNo))
+	if err != nil {
+		return nil, fmt.Errorf("본문 페이지 가져오기 실패: %w", err)
+	}
+	return doc, nil
+}
+
+// fetchNaverFrameset은 프레임셋 페이지에서 iframe URL을 찾아 실제 콘텐츠 문서를 가져옵니다
+func fetchNaverFrameset(url string) (*goquery.Document, error) {
+	// 먼저 프레임셋 페이지 가져오기
+	framesetDoc, err := fetchHTML(url)
+	if err != nil {
+		return nil, fmt.Errorf("프레임셋 페이지 가져오기 실패: %w", err)
+	}
+
+	// iframe 태그에서 실제 콘텐츠 URL 추출
+	iframeURL := extractNaverIframeURL(framesetDoc, url)
+	if iframeURL == url {
+		return framesetDoc, nil
+	}
+
+	contentDoc, err := fetchHTML(iframeURL)
+	if err != nil {
+		return nil, fmt.Errorf("iframe 내부 콘텐츠 가져오기 실패: %w", err)
+	}
+	return contentDoc, nil
+}
+
+// Parse는 포스트를 작성한 에디터 세대를 판별하여 해당 세대의 파서로 본문을 파싱합니다
+// 카테고리, 발행 시각 등 포스트 부가 정보도 함께 추출합니다
+func (a *NaverBlogAdapter) Parse(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	result.Metadata = extractNaverMetadata(doc)
+	parseNaverDocument(doc, result, is2025OrLater)
+}
+
+// parseNaverBlogURL은 네이버 블로그 URL에서 blogId와 logNo를 추출합니다
+// 지원하는 URL 형식은 structure.ParsePostID를 따릅니다
+func parseNaverBlogURL(rawURL string) (string, string, bool) {
+	id, err := structure.ParsePostID(rawURL)
+	if err != nil || id.Platform != structure.PlatformNaverBlog {
+		return "", "", false
+	}
+	return id.Owner, id.PostNo, true
+}
+
+// naverPostViewURL은 프레임셋을 거치지 않는 PC 본문 페이지 URL을 생성합니다
+func naverPostViewURL(blogID, logNo string) string {
+	return fmt.Sprintf("https://blog.naver.com/PostView.naver?blogId=%s&logNo=%s", url.QueryEscape(blogID), logNo)
+}
+
+// naverMobileURL은 모바일 본문 페이지 URL을 생성합니다
+func naverMobileURL(blogID, logNo string) string {
+	return fmt.Sprintf("https://m.blog.naver.com/%s/%s", url.PathEscape(blogID), logNo)
+}
+
+// scopeDocument는 선택자에 해당하는 본문 영역만 포함하는 문서를 만듭니다
+// 본문 영역을 찾지 못하면 원본 문서를 반환합니다
+func scopeDocument(doc *goquery.Document, selectors []string) *goquery.Document {
+	for _, selector := range selectors {
+		selected := doc.Find(selector).First()
+		if selected.Length() > 0 {
+			return goquery.NewDocumentFromNode(selected.Nodes[0])
+		}
+	}
+	return doc
+}
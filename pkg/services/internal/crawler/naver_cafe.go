@@ -0,0 +1,113 @@
+package crawler
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// 네이버 카페 게시글 본문 영역 선택자
+var cafeContentSelectors = []string{
+	".se-main-container", // 스마트에디터 ONE
+	"#tbody",             // 구버전 카페 에디터
+	".tbody",             // 구버전 카페 에디터
+	"#postContent",       // 모바일 카페
+	".article_viewer",    // 카페 게시글 뷰어
+	".ContentRenderer",   // 카페 게시글 렌더러
+}
+
+// NaverCafeAdapter는 네이버 카페 게시글 플랫폼 어댑터입니다
+type NaverCafeAdapter struct{}
+
+// NewNaverCafeAdapter는 새 네이버 카페 어댑터를 생성합니다
+func NewNaverCafeAdapter() *NaverCafeAdapter {
+	return &NaverCafeAdapter{}
+}
+
+// Name은 플랫폼 이름을 반환합니다
+func (a *NaverCafeAdapter) Name() string {
+	return string(structure.PlatformNaverCafe)
+}
+
+// Match는 네이버 카페 URL인지 확인합니다
+func (a *NaverCafeAdapter) Match(url string) bool {
+	return strings.Contains(url, "cafe.naver.com")
+}
+
+// Fetch는 카페 프레임 페이지에서 cafe_main iframe URL을 찾아 게시글 문서를 가져옵니다
+func (a *NaverCafeAdapter) Fetch(rawURL string) (*goquery.Document, error) {
+	// iframe_url 파라미터가 있으면 프레임 페이지를 거치지 않고 바로 게시글을 가져옴
+	if iframeURL := cafeIframeURLFromQuery(rawURL); iframeURL != "" {
+		doc, err := fetchHTML(iframeURL)
+		if err != nil {
+			return nil, fmt.Errorf("카페 게시글 가져오기 실패: %w", err)
+		}
+		return doc, nil
+	}
+
+	frameDoc, err := fetchHTML(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("카페 프레임 페이지 가져오기 실패: %w", err)
+	}
+
+	iframeURL := extractCafeIframeURL(frameDoc)
+	if iframeURL == "" {
+		return frameDoc, nil
+	}
+
+	contentDoc, err := fetchHTML(iframeURL)
+	if err != nil {
+		return nil, fmt.Errorf("카페 iframe 내부 콘텐츠 가져오기 실패: %w", err)
+	}
+	return contentDoc, nil
+}
+
+// Parse는 카페 게시글 본문 영역을 찾아 네이버 블로그와 같은 방식으로 파싱합니다
+func (a *NaverCafeAdapter) Parse(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	contentDoc := scopeDocument(doc, cafeContentSelectors)
+	parseNaverDocument(contentDoc, result, is2025OrLater)
+}
+
+// cafeIframeURLFromQuery는 카페 URL의 iframe_url 파라미터에서 게시글 URL을 추출합니다
+func cafeIframeURLFromQuery(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	iframeURL := parsed.Query().Get("iframe_url")
+	if iframeURL == "" {
+		iframeURL = parsed.Query().Get("iframe_url_utf8")
+	}
+	if iframeURL == "" {
+		return ""
+	}
+	return absoluteCafeURL(iframeURL)
+}
+
+// extractCafeIframeURL은 카페 프레임 페이지에서 cafe_main iframe URL을 추출합니다
+func extractCafeIframeURL(doc *goquery.Document) string {
+	src := doc.Find("iframe#cafe_main").First().AttrOr("src", "")
+	if src == "" || strings.HasPrefix(src, "about:") {
+		return ""
+	}
+	return absoluteCafeURL(src)
+}
+
+// absoluteCafeURL은 카페 상대 경로를 절대 경로로 변환합니다
+func absoluteCafeURL(path string) string {
+	if strings.HasPrefix(path, "http") {
+		return path
+	}
+	if strings.HasPrefix(path, "//") {
+		return "https:" + path
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return "https://cafe.naver.com" + path
+}
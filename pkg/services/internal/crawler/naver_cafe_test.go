@@ -0,0 +1,141 @@
+package crawler
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestNaverCafeAdapterMatch(t *testing.T) {
+	adapter := NewNaverCafeAdapter()
+
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://cafe.naver.com/joonggonara/1234", true},
+		{"https://m.cafe.naver.com/ca-fe/web/cafes/10050146/articles/1234", true},
+		{"https://cafe.naver.com/ArticleRead.nhn?clubid=10050146&articleid=1234", true},
+		{"https://blog.naver.com/foodie/223000000001", false},
+		{"https://post.naver.com/viewer/postView.naver?volumeNo=1&memberNo=2", false},
+	}
+
+	for _, tt := range tests {
+		if got := adapter.Match(tt.url); got != tt.want {
+			t.Errorf("Match(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestCafeIframeURL(t *testing.T) {
+	// 프레임 페이지의 cafe_main iframe 상대 경로를 절대 경로로 변환
+	doc := loadFixture(t, "cafe/frame.html")
+	want := "https://cafe.naver.com/ArticleRead.nhn?clubid=10050146&articleid=1234&referrerAllArticles=true"
+	if got := extractCafeIframeURL(doc); got != want {
+		t.Errorf("extractCafeIframeURL = %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://cafe.naver.com/joonggonara?iframe_url=/ArticleRead.nhn%3Fclubid=10050146%26articleid=1234", "https://cafe.naver.com/ArticleRead.nhn?clubid=10050146&articleid=1234"},
+		{"https://cafe.naver.com/joonggonara?iframe_url_utf8=%2Fca-fe%2Fcafes%2F10050146%2Farticles%2F1234", "https://cafe.naver.com/ca-fe/cafes/10050146/articles/1234"},
+		{"https://cafe.naver.com/joonggonara?iframe_url=//cafe.naver.com/ArticleRead.nhn", "https://cafe.naver.com/ArticleRead.nhn"},
+		{"https://cafe.naver.com/joonggonara/1234", ""},
+	}
+	for _, tt := range tests {
+		if got := cafeIframeURLFromQuery(tt.url); got != tt.want {
+			t.Errorf("cafeIframeURLFromQuery(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestNaverCafeAdapterParse(t *testing.T) {
+	adapter := NewNaverCafeAdapter()
+
+	tests := []struct {
+		is2025OrLater bool
+		want          structure.CrawlResult
+	}{
+		{
+			want: structure.CrawlResult{
+				EditorVersion:   structure.EditorSmartEditorOne,
+				FirstParagraph:  "카페 체험단으로 식사권을 제공받아 방문했습니다. 망원시장 근처 골목에 있는 작은 파스타집이에요. 명란 크림 파스타가 가장 맛있었습니다.",
+				LastParagraph:   "카페 체험단으로 식사권을 제공받아 방문했습니다. 망원시장 근처 골목에 있는 작은 파스타집이에요. 명란 크림 파스타가 가장 맛있었습니다.",
+				FirstImageURL:   "https://cafeptthumb-phinf.pstatic.net/MjAyNTAz/pasta.jpg?type=w740",
+				LastImageURL:    "https://cafeptthumb-phinf.pstatic.net/MjAyNTAz/pasta.jpg?type=w740",
+				FirstStickerURL: "https://storep-phinf.pstatic.net/ogq_5c8e8a2c5b1e3/original_3.png?type=p100_100",
+			},
+		},
+		{
+			is2025OrLater: true,
+			want: structure.CrawlResult{
+				EditorVersion:   structure.EditorSmartEditorOne,
+				FirstParagraph:  "카페 체험단으로 식사권을 제공받아 방문했습니다. 망원시장 근처 골목에 있는 작은 파스타집이에요. 명란 크림 파스타가 가장 맛있었습니다.",
+				FirstImageURL:   "https://cafeptthumb-phinf.pstatic.net/MjAyNTAz/pasta.jpg?type=w740",
+				FirstStickerURL: "https://storep-phinf.pstatic.net/ogq_5c8e8a2c5b1e3/original_3.png?type=p100_100",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		doc := loadFixture(t, "cafe/article.html")
+		got := structure.CrawlResult{}
+		adapter.Parse(doc, &got, tt.is2025OrLater)
+
+		// 게시글 제목, 댓글, 인기글 목록은 본문에서 제외
+		for _, excluded := range []string{"망원동 파스타집 후기", "명란 파스타 추천해요", "연남동 브런치"} {
+			if strings.Contains(got.Content, excluded) {
+				t.Errorf("본문에 %q가 포함되었습니다: %q", excluded, got.Content)
+			}
+		}
+
+		got.Blocks = nil
+		got.Content = ""
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Parse(is2025OrLater=%v)\n got: %+v\nwant: %+v", tt.is2025OrLater, got, tt.want)
+		}
+	}
+}
+
+func TestNaverCafeAdapterFetchFollowsIframe(t *testing.T) {
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+
+	article, err := os.ReadFile(filepath.Join("testdata", "cafe", "article.html"))
+	if err != nil {
+		t.Fatalf("픽스처 읽기 실패: %v", err)
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		switch r.URL.Path {
+		case "/foodclub/1234":
+			fmt.Fprintf(w, `<html><body><iframe id="cafe_main" src="%s/ArticleRead.nhn?clubid=10050146&amp;articleid=1234"></iframe></body></html>`, server.URL)
+		case "/ArticleRead.nhn":
+			w.Write(article)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// 프레임 페이지의 cafe_main iframe을 따라가 게시글 문서를 가져옴
+	doc, err := NewNaverCafeAdapter().Fetch(server.URL + "/foodclub/1234")
+	if err != nil {
+		t.Fatalf("Fetch 에러: %v", err)
+	}
+	if doc.Find(".se-main-container").Length() == 0 {
+		t.Error("iframe 내부 게시글 문서를 가져오지 못했습니다")
+	}
+}
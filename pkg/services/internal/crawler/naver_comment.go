@@ -0,0 +1,175 @@
+package crawler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+	"github.com/sh5080/ndns-go/pkg/utils"
+)
+
+// 댓글 목록 API 주소 (테스트에서 교체)
+var naverCommentAPIURL = constants.NAVER_COMMENT_API_URL
+
+// 본문 페이지 스크립트에서 블로그 번호를 찾는 정규식 (var blogNo = '12345'; 또는 "blogNo":12345)
+var blogNoRegex = regexp.MustCompile(`blogNo["']?\s*[=:]\s*["']?(\d+)`)
+
+// naverCommentResponse는 댓글 목록 API 응답입니다
+type naverCommentResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Result  struct {
+		CommentList []naverComment `json:"commentList"`
+		PageModel   struct {
+			TotalPages int `json:"totalPages"`
+		} `json:"pageModel"`
+	} `json:"result"`
+}
+
+// naverComment는 댓글 목록 API의 댓글 하나입니다
+type naverComment struct {
+	Contents   string `json:"contents"`
+	UserName   string `json:"userName"`
+	ReplyLevel int    `json:"replyLevel"` // 1: 댓글, 2: 답글
+	Manager    bool   `json:"manager"`    // 블로그 주인 여부
+	Deleted    bool   `json:"deleted"`
+	Secret     bool   `json:"secret"`
+}
+
+// FetchComments는 본문 페이지가 불러오는 댓글 목록 API로 포스트의 댓글을 가져옵니다
+// 최대 COMMENT_MAX_PAGES 페이지까지 가져오며, 삭제되거나 비밀 댓글은 제외합니다
+func (a *NaverBlogAdapter) FetchComments(doc *goquery.Document, postURL string) ([]structure.PostComment, error) {
+	blogID, logNo, ok := parseNaverBlogURL(postURL)
+	if !ok {
+		return nil, fmt.Errorf("포스트 URL 해석 실패: %s", postURL)
+	}
+	blogNo := extractBlogNo(doc)
+	if blogNo == "" {
+		return nil, fmt.Errorf("블로그 번호를 찾지 못했습니다: %s", postURL)
+	}
+
+	var comments []structure.PostComment
+	for page := 1; page <= constants.COMMENT_MAX_PAGES; page++ {
+		response, err := fetchNaverCommentPage(blogNo, logNo, naverPostViewURL(blogID, logNo), page)
+		if err != nil {
+			if len(comments) > 0 {
+				// 앞 페이지까지 가져온 댓글은 사용
+				fmt.Printf("댓글 %d페이지 가져오기 실패 (이전 페이지까지 사용): %v\n", page, err)
+				break
+			}
+			return nil, err
+		}
+
+		for _, comment := range response.Result.CommentList {
+			if comment.Deleted || comment.Secret {
+				continue
+			}
+			text := utils.RemoveHTMLTags(comment.Contents)
+			if text == "" {
+				continue
+			}
+			comments = append(comments, structure.PostComment{
+				Author:  comment.UserName,
+				Text:    text,
+				IsOwner: comment.Manager,
+				IsReply: comment.ReplyLevel > 1,
+			})
+		}
+
+		if page >= response.Result.PageModel.TotalPages {
+			break
+		}
+	}
+	return comments, nil
+}
+
+// fetchNaverCommentPage는 댓글 목록 API에서 한 페이지를 가져옵니다
+func fetchNaverCommentPage(blogNo, logNo, referer string, page int) (*naverCommentResponse, error) {
+	query := url.Values{
+		"ticket":        {"blog"},
+		"templateId":    {"default"},
+		"pool":          {"blogid"},
+		"lang":          {"ko"},
+		"objectId":      {blogNo + "_201_" + logNo},
+		"groupId":       {blogNo},
+		"listType":      {"OBJECT"},
+		"pageType":      {"default"},
+		"pageSize":      {strconv.Itoa(constants.COMMENT_PAGE_SIZE)},
+		"page":          {strconv.Itoa(page)},
+		"showReply":     {"true"},
+		"useAltSort":    {"true"},
+		"initialize":    {strconv.FormatBool(page == 1)},
+		"replyPageSize": {strconv.Itoa(constants.COMMENT_PAGE_SIZE)},
+	}
+
+	req, err := http.NewRequest("GET", naverCommentAPIURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("댓글 요청 생성 실패: %v", err)
+	}
+	// 댓글 API는 본문 페이지에서 호출한 요청만 허용
+	req.Header.Set("Referer", referer)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
+
+	resp, err := transport.NewClient(constants.TIMEOUT).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("댓글 요청 실행 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := reportBlockedStatus(req.URL.Hostname(), resp.StatusCode); err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("댓글 API 응답 오류: HTTP %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("댓글 응답 읽기 실패: %v", err)
+	}
+
+	var response naverCommentResponse
+	if err := json.Unmarshal(unwrapJSONP(body), &response); err != nil {
+		return nil, fmt.Errorf("댓글 응답 파싱 실패: %v", err)
+	}
+	if !response.Success {
+		return nil, fmt.Errorf("댓글 API 오류: %s", response.Message)
+	}
+	return &response, nil
+}
+
+// unwrapJSONP는 "_callback({...});" 형태의 JSONP 응답에서 JSON 부분만 꺼냅니다
+// JSONP가 아니면 그대로 반환합니다
+func unwrapJSONP(body []byte) []byte {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || body[0] == '{' {
+		return body
+	}
+
+	start := bytes.IndexByte(body, '(')
+	end := bytes.LastIndexByte(body, ')')
+	if start < 0 || end <= start {
+		return body
+	}
+	return body[start+1 : end]
+}
+
+// extractBlogNo는 본문 페이지 스크립트에서 댓글 API에 필요한 블로그 번호를 찾습니다
+func extractBlogNo(doc *goquery.Document) string {
+	var blogNo string
+	doc.Find("script").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		blogNo = firstSubmatch(blogNoRegex, s.Text())
+		return blogNo == ""
+	})
+	return blogNo
+}
@@ -0,0 +1,85 @@
+package crawler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestNaverFetchComments(t *testing.T) {
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if got := r.URL.Query().Get("objectId"); got != "12345_201_223456789012" {
+			t.Errorf("objectId = %q", got)
+		}
+		if referer := r.Header.Get("Referer"); !strings.Contains(referer, "logNo=223456789012") {
+			t.Errorf("Referer = %q", referer)
+		}
+
+		// 1페이지를 제외한 나머지 페이지는 같은 응답 사용
+		fixture := "naver_page2.json"
+		if r.URL.Query().Get("page") == "1" {
+			fixture = "naver_page1.json"
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", "comments", fixture))
+		if err != nil {
+			t.Errorf("픽스처 읽기 실패: %v", err)
+		}
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	naverCommentAPIURL = server.URL
+	defer func() { naverCommentAPIURL = constants.NAVER_COMMENT_API_URL }()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head><script>var blogNo = '12345';</script></head><body></body></html>"))
+	if err != nil {
+		t.Fatalf("HTML 파싱 실패: %v", err)
+	}
+
+	comments, err := NewNaverBlogAdapter().FetchComments(doc, "https://blog.naver.com/foodie/223456789012")
+	if err != nil {
+		t.Fatalf("FetchComments 실패: %v", err)
+	}
+
+	// 전체 5페이지 중 최대 페이지 수까지만 요청
+	if got := atomic.LoadInt32(&requests); got != constants.COMMENT_MAX_PAGES {
+		t.Errorf("요청 수 = %d, want %d", got, constants.COMMENT_MAX_PAGES)
+	}
+
+	// 삭제된 댓글과 비밀 댓글은 제외
+	want := []structure.PostComment{
+		{Author: "맛집탐방러", Text: "혹시 협찬인가요?"},
+		{Author: "foodie", Text: "네 체험단으로 다녀왔어요 솔직하게 적었습니다!", IsOwner: true, IsReply: true},
+		{Author: "여행가", Text: "사진 정말 맛있어 보여요 & 가보고 싶네요"},
+	}
+	if !reflect.DeepEqual(comments[:len(want)], want) {
+		t.Errorf("댓글 = %+v, want %+v", comments[:len(want)], want)
+	}
+}
+
+func TestNaverFetchCommentsWithoutBlogNo(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><body></body></html>"))
+	if err != nil {
+		t.Fatalf("HTML 파싱 실패: %v", err)
+	}
+
+	if _, err := NewNaverBlogAdapter().FetchComments(doc, "https://blog.naver.com/foodie/223456789012"); err == nil {
+		t.Error("블로그 번호가 없는 페이지에서 에러가 발생하지 않았습니다")
+	}
+}
@@ -0,0 +1,67 @@
+package crawler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// 네이버 포스트 본문 영역 선택자
+var postContentSelectors = []string{
+	".se-main-container",  // 스마트에디터 ONE
+	".se_component_wrap",  // 스마트에디터 3.0
+	"#cont",               // 포스트 뷰어 본문
+	".__viewer_container", // 포스트 뷰어 컨테이너
+}
+
+// NaverPostAdapter는 네이버 포스트(post.naver.com) 플랫폼 어댑터입니다
+type NaverPostAdapter struct{}
+
+// NewNaverPostAdapter는 새 네이버 포스트 어댑터를 생성합니다
+func NewNaverPostAdapter() *NaverPostAdapter {
+	return &NaverPostAdapter{}
+}
+
+// Name은 플랫폼 이름을 반환합니다
+func (a *NaverPostAdapter) Name() string {
+	return string(structure.PlatformNaverPost)
+}
+
+// Match는 네이버 포스트 URL인지 확인합니다
+func (a *NaverPostAdapter) Match(url string) bool {
+	return strings.Contains(url, "post.naver.com")
+}
+
+// Fetch는 네이버 포스트 뷰어 페이지를 가져옵니다
+func (a *NaverPostAdapter) Fetch(url string) (*goquery.Document, error) {
+	doc, err := fetchHTML(url)
+	if err != nil {
+		return nil, fmt.Errorf("네이버 포스트 페이지 가져오기 실패: %w", err)
+	}
+	return doc, nil
+}
+
+// Parse는 포스트 뷰어의 본문 템플릿을 펼쳐 네이버 블로그와 같은 방식으로 파싱합니다
+func (a *NaverPostAdapter) Parse(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	contentDoc := scopeDocument(expandPostClipContent(doc), postContentSelectors)
+	parseNaverDocument(contentDoc, result, is2025OrLater)
+}
+
+// expandPostClipContent는 포스트 뷰어가 스크립트 템플릿(#__clipContent)에 담아둔 본문 HTML을 문서로 변환합니다
+// 템플릿이 없으면 원본 문서를 반환합니다
+func expandPostClipContent(doc *goquery.Document) *goquery.Document {
+	clipContent := strings.TrimSpace(doc.Find("#__clipContent").First().Text())
+	if clipContent == "" {
+		return doc
+	}
+
+	clipDoc, err := goquery.NewDocumentFromReader(strings.NewReader(clipContent))
+	if err != nil {
+		fmt.Printf("포스트 본문 템플릿 파싱 실패 (원본 사용): %v\n", err)
+		return doc
+	}
+	return clipDoc
+}
@@ -0,0 +1,83 @@
+package crawler
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestNaverPostAdapterMatch(t *testing.T) {
+	adapter := NewNaverPostAdapter()
+
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://post.naver.com/viewer/postView.naver?volumeNo=35000001&memberNo=1234567", true},
+		{"https://m.post.naver.com/viewer/postView.naver?volumeNo=35000001&memberNo=1234567", true},
+		{"https://blog.naver.com/foodie/223000000001", false},
+		{"https://cafe.naver.com/joonggonara/1234", false},
+	}
+
+	for _, tt := range tests {
+		if got := adapter.Match(tt.url); got != tt.want {
+			t.Errorf("Match(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestNaverPostAdapterParse(t *testing.T) {
+	adapter := NewNaverPostAdapter()
+
+	tests := []struct {
+		is2025OrLater bool
+		want          structure.CrawlResult
+	}{
+		{
+			want: structure.CrawlResult{
+				EditorVersion:  structure.EditorSmartEditorOne,
+				FirstParagraph: "이번 여행은 제주관광공사 지원으로 다녀왔습니다. 협재 해변 앞 카페부터 시작했어요. 노을 시간에 맞춰 가면 창가 자리가 명당입니다.",
+				LastParagraph:  "이번 여행은 제주관광공사 지원으로 다녀왔습니다. 협재 해변 앞 카페부터 시작했어요. 노을 시간에 맞춰 가면 창가 자리가 명당입니다.",
+				FirstImageURL:  "https://post-phinf.pstatic.net/MjAyNTA0/cafe1.jpg?type=w800",
+				LastImageURL:   "https://post-phinf.pstatic.net/MjAyNTA0/cafe2.jpg?type=w800",
+			},
+		},
+		{
+			is2025OrLater: true,
+			want: structure.CrawlResult{
+				EditorVersion:  structure.EditorSmartEditorOne,
+				FirstParagraph: "이번 여행은 제주관광공사 지원으로 다녀왔습니다. 협재 해변 앞 카페부터 시작했어요. 노을 시간에 맞춰 가면 창가 자리가 명당입니다.",
+				FirstImageURL:  "https://post-phinf.pstatic.net/MjAyNTA0/cafe1.jpg?type=w800",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		doc := loadFixture(t, "post/clip_content.html")
+		got := structure.CrawlResult{}
+		adapter.Parse(doc, &got, tt.is2025OrLater)
+
+		// #__clipContent 템플릿의 본문을 사용하고, 뷰어의 자리표시 문구와 관련 포스트는 제외
+		for _, excluded := range []string{"본문을 불러오는 중", "제주 동쪽 맛집 지도"} {
+			if strings.Contains(got.Content, excluded) {
+				t.Errorf("본문에 %q가 포함되었습니다: %q", excluded, got.Content)
+			}
+		}
+
+		got.Blocks = nil
+		got.Content = ""
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Parse(is2025OrLater=%v)\n got: %+v\nwant: %+v", tt.is2025OrLater, got, tt.want)
+		}
+	}
+}
+
+func TestExpandPostClipContentWithoutTemplate(t *testing.T) {
+	// 템플릿이 없는 문서는 그대로 사용
+	doc := loadFixture(t, "cafe/article.html")
+	if expanded := expandPostClipContent(doc); expanded != doc {
+		t.Error("템플릿이 없는 문서를 다른 문서로 바꾸었습니다")
+	}
+}
@@ -0,0 +1,34 @@
+package crawler
+
+import "testing"
+
+func TestParseNaverBlogURL(t *testing.T) {
+	tests := []struct {
+		url        string
+		wantBlogID string
+		wantLogNo  string
+		wantOK     bool
+	}{
+		{"https://blog.naver.com/foodie/223456789012", "foodie", "223456789012", true},
+		{"blog.naver.com/foodie/223456789012", "foodie", "223456789012", true},
+		{"http://m.blog.naver.com/foodie/223456789012?referrerCode=1", "foodie", "223456789012", true},
+		{"https://blog.naver.com/PostView.naver?blogId=foodie&logNo=223456789012", "foodie", "223456789012", true},
+		{"https://blog.naver.com/PostView.nhn?blogId=foodie&logNo=223456789012&redirect=Dlog", "foodie", "223456789012", true},
+		{"https://m.blog.naver.com/PostView.naver?blogId=foodie&logNo=223456789012", "foodie", "223456789012", true},
+		{"https://blog.naver.com/PostList.naver?blogId=foodie&logNo=223456789012", "foodie", "223456789012", true},
+		{"https://blog.naver.com/foodie?Redirect=Log&logNo=223456789012", "foodie", "223456789012", true},
+		{"https://foodie.blog.me/223456789012", "foodie", "223456789012", true},
+		{"https://blog.naver.com/foodie", "", "", false},
+		{"https://blog.naver.com/foodie/category", "", "", false},
+		{"https://blog.naver.com/PostView.naver?blogId=foodie", "", "", false},
+		{"https://cafe.naver.com/foodie/12345", "", "", false},
+	}
+
+	for _, tt := range tests {
+		blogID, logNo, ok := parseNaverBlogURL(tt.url)
+		if blogID != tt.wantBlogID || logNo != tt.wantLogNo || ok != tt.wantOK {
+			t.Errorf("parseNaverBlogURL(%q) = (%q, %q, %v), want (%q, %q, %v)",
+				tt.url, blogID, logNo, ok, tt.wantBlogID, tt.wantLogNo, tt.wantOK)
+		}
+	}
+}
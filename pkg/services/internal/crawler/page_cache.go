@@ -0,0 +1,223 @@
+package crawler
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sh5080/ndns-go/pkg/configs"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// pageCacheEntry는 디스크에 저장하는 페이지 응답입니다
+type pageCacheEntry struct {
+	Key          string    `json:"key"` // 캐시 키 (포스트 대표 URL)
+	URL          string    `json:"url"` // 실제로 요청한 URL (재검증 요청에 사용)
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	StoredAt     time.Time `json:"storedAt"` // 저장 또는 마지막 재검증 시각
+	Body         []byte    `json:"body"`
+}
+
+// pageCacheItem은 LRU 목록의 항목입니다
+type pageCacheItem struct {
+	file string
+	size int64
+}
+
+// pageCache는 크롤링한 페이지를 디스크에 저장하는 LRU 캐시입니다
+// 최대 보관 시간이 지난 항목은 ETag/Last-Modified로 재검증하며, 전체 크기가 최대 크기를 넘으면 오래 사용하지 않은 항목부터 삭제합니다
+type pageCache struct {
+	dir     string
+	maxAge  time.Duration
+	maxSize int64
+	items   map[string]*list.Element
+	lru     *list.List // 앞쪽이 최근 사용한 항목
+	size    int64
+	lock    sync.Mutex
+}
+
+var (
+	// fetchHTML이 사용하는 페이지 캐시 (nil이면 캐시를 사용하지 않음)
+	sharedPageCache *pageCache
+	pageCacheOnce   sync.Once
+)
+
+// configurePageCache는 설정에 따라 fetchHTML이 사용할 페이지 캐시를 한 번만 구성합니다
+func configurePageCache(config *configs.EnvConfig) {
+	pageCacheOnce.Do(func() {
+		if config == nil || config.PageCache.Dir == "" {
+			return
+		}
+
+		cache, err := newPageCache(config.PageCache.Dir, config.PageCache.MaxAge, config.PageCache.MaxSizeMB*1024*1024)
+		if err != nil {
+			fmt.Printf("페이지 캐시 초기화 실패 (캐시 없이 진행): %v\n", err)
+			return
+		}
+		sharedPageCache = cache
+	})
+}
+
+// newPageCache는 디렉토리에 저장된 항목을 불러와 페이지 캐시를 생성합니다
+// 기존 항목의 사용 순서는 파일 수정 시각으로 복원합니다
+func newPageCache(dir string, maxAge time.Duration, maxSize int64) (*pageCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("캐시 디렉토리 생성 실패: %v", err)
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("캐시 디렉토리 읽기 실패: %v", err)
+	}
+
+	type storedFile struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	var stored []storedFile
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			continue
+		}
+		stored = append(stored, storedFile{name: file.Name(), size: info.Size(), modTime: info.ModTime()})
+	}
+	// 최근 사용한 항목이 앞쪽에 오도록 정렬
+	sort.Slice(stored, func(i, j int) bool {
+		return stored[i].modTime.After(stored[j].modTime)
+	})
+
+	cache := &pageCache{
+		dir:     dir,
+		maxAge:  maxAge,
+		maxSize: maxSize,
+		items:   make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+	for _, file := range stored {
+		cache.items[file.name] = cache.lru.PushBack(&pageCacheItem{file: file.name, size: file.size})
+		cache.size += file.size
+	}
+
+	cache.lock.Lock()
+	cache.evict()
+	cache.lock.Unlock()
+	return cache, nil
+}
+
+// Get은 캐시 항목과 재검증 없이 사용할 수 있는지 여부를 반환합니다
+// 항목이 없으면 nil을 반환합니다
+func (c *pageCache) Get(key string) (*pageCacheEntry, bool) {
+	file := pageCacheFile(key)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, exists := c.items[file]
+	if !exists {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.dir, file))
+	if err != nil {
+		c.remove(element)
+		return nil, false
+	}
+
+	var entry pageCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Key != key {
+		c.remove(element)
+		return nil, false
+	}
+
+	// 사용 순서 갱신 (재시작 후에도 유지되도록 파일 수정 시각도 갱신)
+	c.lru.MoveToFront(element)
+	now := time.Now()
+	os.Chtimes(filepath.Join(c.dir, file), now, now)
+
+	return &entry, time.Since(entry.StoredAt) < c.maxAge
+}
+
+// Put은 캐시 항목을 저장하고 최대 크기를 넘으면 오래 사용하지 않은 항목을 삭제합니다
+func (c *pageCache) Put(entry pageCacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("캐시 항목 직렬화 실패: %v", err)
+	}
+
+	file := pageCacheFile(entry.Key)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// 다른 요청이 읽는 중에도 깨진 파일이 보이지 않도록 임시 파일에 쓴 뒤 교체
+	tempPath := filepath.Join(c.dir, file+".tmp")
+	if err := os.WriteFile(tempPath, data, 0o644); err != nil {
+		return fmt.Errorf("캐시 파일 쓰기 실패: %v", err)
+	}
+	if err := os.Rename(tempPath, filepath.Join(c.dir, file)); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("캐시 파일 교체 실패: %v", err)
+	}
+
+	size := int64(len(data))
+	if element, exists := c.items[file]; exists {
+		item := element.Value.(*pageCacheItem)
+		c.size += size - item.size
+		item.size = size
+		c.lru.MoveToFront(element)
+	} else {
+		c.items[file] = c.lru.PushFront(&pageCacheItem{file: file, size: size})
+		c.size += size
+	}
+
+	c.evict()
+	return nil
+}
+
+// evict는 전체 크기가 최대 크기 이하가 될 때까지 오래 사용하지 않은 항목을 삭제합니다
+// 호출 전에 lock을 잡고 있어야 합니다
+func (c *pageCache) evict() {
+	for c.size > c.maxSize && c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove는 항목을 목록과 디스크에서 삭제합니다
+// 호출 전에 lock을 잡고 있어야 합니다
+func (c *pageCache) remove(element *list.Element) {
+	item := element.Value.(*pageCacheItem)
+	c.lru.Remove(element)
+	delete(c.items, item.file)
+	c.size -= item.size
+	os.Remove(filepath.Join(c.dir, item.file))
+}
+
+// pageCacheKey는 URL의 캐시 키를 반환합니다
+// 같은 포스트의 PC/모바일/PostView URL은 포스트 대표 URL을 키로 사용합니다
+func pageCacheKey(rawURL string) string {
+	postID, err := structure.ParsePostID(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return postID.CanonicalURL()
+}
+
+// pageCacheFile은 캐시 키에 해당하는 파일 이름을 반환합니다
+func pageCacheFile(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:]) + ".json"
+}
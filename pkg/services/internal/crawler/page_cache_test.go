@@ -0,0 +1,120 @@
+package crawler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+)
+
+func TestPageCacheKey(t *testing.T) {
+	want := pageCacheKey("https://blog.naver.com/foodie/223456789012")
+	for _, url := range []string{
+		"https://blog.naver.com/PostView.naver?blogId=foodie&logNo=223456789012",
+		"https://m.blog.naver.com/foodie/223456789012",
+	} {
+		if got := pageCacheKey(url); got != want {
+			t.Errorf("pageCacheKey(%q) = %q, want %q", url, got, want)
+		}
+	}
+}
+
+func TestPageCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	dir := t.TempDir()
+	entry := func(key string) pageCacheEntry {
+		// 저장 시각의 소수점 자릿수에 따라 항목 크기가 달라지지 않도록 초 단위로 맞춤
+		return pageCacheEntry{Key: key, URL: key, StoredAt: time.Now().Truncate(time.Second), Body: []byte(strings.Repeat("x", 100))}
+	}
+
+	// 항목 하나의 크기로 최대 크기를 정함 (항목 2개까지 보관)
+	probe, err := newPageCache(t.TempDir(), time.Hour, 1<<20)
+	if err != nil {
+		t.Fatalf("newPageCache 실패: %v", err)
+	}
+	probe.Put(entry("https://a.example.com/1"))
+
+	cache, err := newPageCache(dir, time.Hour, probe.size*2)
+	if err != nil {
+		t.Fatalf("newPageCache 실패: %v", err)
+	}
+	cache.Put(entry("https://a.example.com/1"))
+	cache.Put(entry("https://a.example.com/2"))
+
+	// 1번 항목을 사용하면 2번 항목이 가장 오래 사용하지 않은 항목이 됨
+	if got, _ := cache.Get("https://a.example.com/1"); got == nil {
+		t.Fatal("1번 항목이 없습니다")
+	}
+	cache.Put(entry("https://a.example.com/3"))
+
+	if got, _ := cache.Get("https://a.example.com/2"); got != nil {
+		t.Error("가장 오래 사용하지 않은 2번 항목이 삭제되지 않았습니다")
+	}
+	for _, key := range []string{"https://a.example.com/1", "https://a.example.com/3"} {
+		if got, fresh := cache.Get(key); got == nil || !fresh {
+			t.Errorf("%s 항목 = (%v, %v), want 보관 중", key, got != nil, fresh)
+		}
+	}
+
+	// 재시작 후에도 저장된 항목을 사용
+	reopened, err := newPageCache(dir, time.Hour, probe.size*2)
+	if err != nil {
+		t.Fatalf("newPageCache 실패: %v", err)
+	}
+	if got, _ := reopened.Get("https://a.example.com/3"); got == nil {
+		t.Error("재시작 후 3번 항목이 없습니다")
+	}
+}
+
+func TestFetchHTMLUsesPageCache(t *testing.T) {
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+
+	var requests, notModified int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte("<html><body><p>캐시된 본문입니다.</p></body></html>"))
+	}))
+	defer server.Close()
+
+	fetch := func() {
+		t.Helper()
+		doc, err := fetchHTML(server.URL + "/post/1")
+		if err != nil {
+			t.Fatalf("fetchHTML 실패: %v", err)
+		}
+		if text := doc.Find("p").Text(); text != "캐시된 본문입니다." {
+			t.Fatalf("본문 = %q", text)
+		}
+	}
+
+	// 보관 시간 안에는 네트워크 요청 없이 사용
+	cache, err := newPageCache(t.TempDir(), time.Hour, 1<<20)
+	if err != nil {
+		t.Fatalf("newPageCache 실패: %v", err)
+	}
+	sharedPageCache = cache
+	defer func() { sharedPageCache = nil }()
+
+	fetch()
+	fetch()
+	if requests != 1 {
+		t.Errorf("보관 시간 내 요청 수 = %d, want 1", requests)
+	}
+
+	// 보관 시간이 지나면 ETag로 재검증
+	cache.maxAge = 0
+	fetch()
+	if requests != 2 || notModified != 1 {
+		t.Errorf("재검증 요청 수 = %d (304: %d), want 2 (304: 1)", requests, notModified)
+	}
+}
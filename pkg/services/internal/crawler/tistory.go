@@ -0,0 +1,200 @@
+package crawler
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// TistoryAdapter는 티스토리 블로그 플랫폼 어댑터입니다
+type TistoryAdapter struct{}
+
+// NewTistoryAdapter는 새 티스토리 어댑터를 생성합니다
+func NewTistoryAdapter() *TistoryAdapter {
+	return &TistoryAdapter{}
+}
+
+// Name은 플랫폼 이름을 반환합니다
+func (a *TistoryAdapter) Name() string {
+	return string(structure.PlatformTistory)
+}
+
+// Match는 *.tistory.com URL인지 확인합니다
+func (a *TistoryAdapter) Match(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	// www.tistory.com은 티스토리 서비스 페이지이므로 제외
+	host := strings.ToLower(parsed.Hostname())
+	return strings.HasSuffix(host, ".tistory.com") && host != "www.tistory.com"
+}
+
+// MatchDocument는 커스텀 도메인 페이지가 티스토리 마크업인지 확인합니다
+func (a *TistoryAdapter) MatchDocument(doc *goquery.Document) bool {
+	// 티스토리 에디터 본문 클래스는 티스토리에서만 사용됨
+	if doc.Find(".tt_article_useless_p_margin").Length() > 0 {
+		return true
+	}
+
+	// .entry-content 등은 다른 플랫폼에서도 사용하므로 티스토리 공통 리소스가 함께 있는지 확인
+	hasTistoryAsset := doc.Find("meta[property='dg:plink'], link[href*='tistory_admin'], script[src*='tistory_admin']").Length() > 0
+	if !hasTistoryAsset {
+		return false
+	}
+	return findTistoryContentArea(doc) != nil
+}
+
+// Fetch는 티스토리 포스트 페이지를 가져옵니다
+func (a *TistoryAdapter) Fetch(url string) (*goquery.Document, error) {
+	doc, err := fetchHTML(url)
+	if err != nil {
+		return nil, fmt.Errorf("티스토리 페이지 가져오기 실패: %w", err)
+	}
+	return doc, nil
+}
+
+// Parse는 티스토리 본문에서 문단, 이미지, 이모티콘을 추출합니다
+func (a *TistoryAdapter) Parse(doc *goquery.Document, result *structure.CrawlResult, is2025OrLater bool) {
+	contentArea := findTistoryContentArea(doc)
+	if contentArea == nil {
+		fmt.Printf("티스토리 본문 영역을 찾지 못했습니다.\n")
+		return
+	}
+
+	setContentBlocks(result, contentArea)
+
+	paragraphs := extractTistoryParagraphs(contentArea)
+	imageURLs, stickerURLs := extractTistoryImages(contentArea)
+
+	// 문단 설정
+	if len(paragraphs) > 0 {
+		if is2025OrLater {
+			// 2025년 이후 포스트는 앞쪽 문단만 수집
+			result.FirstParagraph = strings.Join(paragraphs[:min(len(paragraphs), 10)], " ")
+		} else {
+			result.FirstParagraph = strings.Join(paragraphs[:min(len(paragraphs), 3)], " ")
+			if len(paragraphs) > 1 {
+				result.LastParagraph = strings.Join(extractLastParagraphs(paragraphs, 3), " ")
+			} else {
+				result.LastParagraph = result.FirstParagraph
+			}
+		}
+	}
+
+	// 이미지 설정
+	if len(imageURLs) > 0 {
+		result.FirstImageURL = imageURLs[0]
+		if !is2025OrLater {
+			result.LastImageURL = imageURLs[len(imageURLs)-1]
+		}
+	}
+
+	// 이모티콘(스티커) 설정
+	if len(stickerURLs) > 0 {
+		result.FirstStickerURL = stickerURLs[0]
+		if len(stickerURLs) > 1 {
+			result.SecondStickerURL = stickerURLs[1]
+			if !is2025OrLater {
+				result.LastStickerURL = stickerURLs[len(stickerURLs)-1]
+			}
+		}
+	}
+}
+
+// findTistoryContentArea는 티스토리 스킨별 본문 영역을 찾습니다
+func findTistoryContentArea(doc *goquery.Document) *goquery.Selection {
+	for _, selector := range constants.TISTORY_CONTENT_SELECTORS {
+		selected := doc.Find(selector).First()
+		if selected.Length() > 0 {
+			return selected
+		}
+	}
+	return nil
+}
+
+// extractTistoryParagraphs는 티스토리 본문에서 문단과 인용구를 순서대로 추출합니다
+func extractTistoryParagraphs(contentArea *goquery.Selection) []string {
+	var paragraphs []string
+
+	contentArea.Find("p, h2, h3, h4, blockquote").Each(func(i int, elem *goquery.Selection) {
+		// 인용구 내부 문단은 인용구 단위로 수집
+		if elem.ParentsFiltered("blockquote").Length() > 0 {
+			return
+		}
+
+		text := cleanText(elem.Text())
+		if text != "" && len(text) > 5 {
+			paragraphs = append(paragraphs, text)
+		}
+	})
+
+	// 문단 태그 없이 줄바꿈으로만 작성된 구버전 글
+	if len(paragraphs) == 0 {
+		for _, line := range strings.Split(contentArea.Text(), "\n") {
+			text := cleanText(line)
+			if text != "" && len(text) > 5 {
+				paragraphs = append(paragraphs, text)
+			}
+		}
+	}
+
+	return paragraphs
+}
+
+// extractTistoryImages는 티스토리 본문에서 일반 이미지와 이모티콘 이미지를 구분하여 추출합니다
+func extractTistoryImages(contentArea *goquery.Selection) ([]string, []string) {
+	var imageURLs, stickerURLs []string
+
+	contentArea.Find("img").Each(func(i int, img *goquery.Selection) {
+		imgURL := img.AttrOr("src", "")
+		if imgURL == "" {
+			imgURL = img.AttrOr("data-src", "")
+		}
+		if imgURL == "" {
+			imgURL = img.AttrOr("data-lazy-src", "")
+		}
+
+		// 프로토콜 생략 URL 처리
+		if strings.HasPrefix(imgURL, "//") {
+			imgURL = "https:" + imgURL
+		}
+		if !strings.HasPrefix(imgURL, "http://") && !strings.HasPrefix(imgURL, "https://") {
+			return
+		}
+
+		if isTistorySticker(img, imgURL) {
+			stickerURLs = append(stickerURLs, imgURL)
+			return
+		}
+
+		// 제외 패턴 확인
+		for _, pattern := range constants.EXCLUDE_IMAGE_PATTERNS {
+			if strings.Contains(imgURL, pattern) {
+				return
+			}
+		}
+		imageURLs = append(imageURLs, imgURL)
+	})
+
+	return imageURLs, stickerURLs
+}
+
+// isTistorySticker는 이미지가 티스토리 이모티콘인지 확인합니다
+func isTistorySticker(img *goquery.Selection, imgURL string) bool {
+	for _, pattern := range constants.TISTORY_STICKER_PATTERNS {
+		if strings.Contains(imgURL, pattern) {
+			return true
+		}
+	}
+
+	if strings.Contains(img.AttrOr("class", ""), "emoticon") {
+		return true
+	}
+	return img.ParentsFiltered("[class*='emoticon']").Length() > 0
+}
@@ -0,0 +1,197 @@
+package crawler
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// loadFixture는 testdata 디렉토리의 HTML 파일을 goquery.Document로 읽어옵니다
+func loadFixture(t *testing.T, name string) *goquery.Document {
+	t.Helper()
+
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("픽스처 열기 실패: %v", err)
+	}
+	defer f.Close()
+
+	doc, err := goquery.NewDocumentFromReader(f)
+	if err != nil {
+		t.Fatalf("픽스처 파싱 실패: %v", err)
+	}
+	return doc
+}
+
+func TestTistoryAdapterMatch(t *testing.T) {
+	adapter := NewTistoryAdapter()
+
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://matjip-diary.tistory.com/128", true},
+		{"https://travelnote.tistory.com/m/77", true},
+		{"https://TravelNote.Tistory.com/entry/jeju", true},
+		{"https://www.tistory.com/", false},
+		{"https://blog.naver.com/user/223000000000", false},
+		{"https://beautylog.example.com/entry/lipstick-review", false},
+	}
+
+	for _, tt := range tests {
+		if got := adapter.Match(tt.url); got != tt.want {
+			t.Errorf("Match(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestTistoryAdapterMatchDocument(t *testing.T) {
+	adapter := NewTistoryAdapter()
+
+	tests := []struct {
+		fixture string
+		want    bool
+	}{
+		{"tistory/book_club.html", true},
+		{"tistory/odyssey.html", true},
+		{"tistory/poster_custom_domain.html", true},
+		{"tistory/wordpress.html", false},
+	}
+
+	for _, tt := range tests {
+		doc := loadFixture(t, tt.fixture)
+		if got := adapter.MatchDocument(doc); got != tt.want {
+			t.Errorf("MatchDocument(%s) = %v, want %v", tt.fixture, got, tt.want)
+		}
+	}
+}
+
+func TestTistoryAdapterParse(t *testing.T) {
+	adapter := NewTistoryAdapter()
+
+	tests := []struct {
+		fixture       string
+		is2025OrLater bool
+		want          structure.CrawlResult
+	}{
+		{
+			fixture: "tistory/book_club.html",
+			want: structure.CrawlResult{
+				FirstParagraph:   "이 포스팅은 업체로부터 제품을 제공받아 작성되었습니다. 성수역 3번 출구에서 5분 거리에 있는 브런치 카페입니다. 메뉴 소개",
+				LastParagraph:    "리코타 샐러드와 프렌치토스트를 주문했어요. 양이 넉넉합니다. 웨이팅은 평일 기준 20분 정도였습니다. 재방문 의사 있어요! 다음에는 디저트도 먹어볼게요.",
+				FirstImageURL:    "https://blog.kakaocdn.net/dn/bA1xyz/btsF1/banner/img.png",
+				LastImageURL:     "https://blog.kakaocdn.net/dn/cZ9abc/btsF2/menu/img.jpg",
+				FirstStickerURL:  "https://t1.daumcdn.net/keditor/emoticon/friends1/large/003.gif",
+				SecondStickerURL: "https://t1.daumcdn.net/keditor/emoticon/friends1/large/012.gif",
+				LastStickerURL:   "https://t1.daumcdn.net/keditor/emoticon/friends1/large/012.gif",
+			},
+		},
+		{
+			fixture:       "tistory/book_club.html",
+			is2025OrLater: true,
+			want: structure.CrawlResult{
+				FirstParagraph:   "이 포스팅은 업체로부터 제품을 제공받아 작성되었습니다. 성수역 3번 출구에서 5분 거리에 있는 브런치 카페입니다. 메뉴 소개 리코타 샐러드와 프렌치토스트를 주문했어요. 양이 넉넉합니다. 웨이팅은 평일 기준 20분 정도였습니다. 재방문 의사 있어요! 다음에는 디저트도 먹어볼게요.",
+				FirstImageURL:    "https://blog.kakaocdn.net/dn/bA1xyz/btsF1/banner/img.png",
+				FirstStickerURL:  "https://t1.daumcdn.net/keditor/emoticon/friends1/large/003.gif",
+				SecondStickerURL: "https://t1.daumcdn.net/keditor/emoticon/friends1/large/012.gif",
+			},
+		},
+		{
+			fixture: "tistory/odyssey.html",
+			want: structure.CrawlResult{
+				FirstParagraph: "애월 바다가 보이는 독채 숙소에 2박 3일 머물렀습니다. 객실은 깔끔했고 침구도 포근했어요. 내돈내산 후기라 솔직하게 적었습니다.",
+				LastParagraph:  "애월 바다가 보이는 독채 숙소에 2박 3일 머물렀습니다. 객실은 깔끔했고 침구도 포근했어요. 내돈내산 후기라 솔직하게 적었습니다.",
+				FirstImageURL:  "https://blog.kakaocdn.net/dn/oldA/room/img.jpg",
+				LastImageURL:   "https://blog.kakaocdn.net/dn/oldA/room/img.jpg",
+			},
+		},
+		{
+			fixture: "tistory/poster_custom_domain.html",
+			want: structure.CrawlResult{
+				FirstParagraph:  "오늘은 신상 립스틱 3종 발색을 비교해 볼게요. 지속력은 3번이 가장 좋았습니다.",
+				LastParagraph:   "오늘은 신상 립스틱 3종 발색을 비교해 볼게요. 지속력은 3번이 가장 좋았습니다.",
+				FirstImageURL:   "https://blog.kakaocdn.net/dn/lip01/swatch/img.jpg",
+				LastImageURL:    "https://blog.kakaocdn.net/dn/lip01/swatch/img.jpg",
+				FirstStickerURL: "https://beautylog.example.com/skin/images/hello.png",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		doc := loadFixture(t, tt.fixture)
+		got := structure.CrawlResult{}
+		adapter.Parse(doc, &got, tt.is2025OrLater)
+
+		// 본문 블록은 TestTistoryAdapterParseBlocks에서 확인
+		got.Blocks = nil
+		got.Content = ""
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Parse(%s, is2025OrLater=%v)\n got: %+v\nwant: %+v", tt.fixture, tt.is2025OrLater, got, tt.want)
+		}
+	}
+}
+
+func TestTistoryAdapterParseBlocks(t *testing.T) {
+	adapter := NewTistoryAdapter()
+	doc := loadFixture(t, "tistory/book_club.html")
+
+	got := structure.CrawlResult{}
+	adapter.Parse(doc, &got, false)
+
+	want := []structure.ContentBlock{
+		{Type: structure.BlockTypeText, Text: "이 포스팅은 업체로부터 제품을 제공받아 작성되었습니다."},
+		{Type: structure.BlockTypeImage, ImageURL: "https://blog.kakaocdn.net/dn/bA1xyz/btsF1/banner/img.png"},
+		{Type: structure.BlockTypeText, Text: "성수역 3번 출구에서 5분 거리에 있는 브런치 카페입니다."},
+		{Type: structure.BlockTypeSticker, ImageURL: "https://t1.daumcdn.net/keditor/emoticon/friends1/large/003.gif"},
+		{Type: structure.BlockTypeText, Text: "메뉴 소개"},
+		{Type: structure.BlockTypeText, Text: "리코타 샐러드와 프렌치토스트를 주문했어요. 양이 넉넉합니다."},
+		{Type: structure.BlockTypeQuote, Text: "웨이팅은 평일 기준 20분 정도였습니다."},
+		{Type: structure.BlockTypeImage, ImageURL: "https://blog.kakaocdn.net/dn/cZ9abc/btsF2/menu/img.jpg"},
+		{Type: structure.BlockTypeText, Text: "재방문 의사 있어요! 다음에는 디저트도 먹어볼게요."},
+		{Type: structure.BlockTypeSticker, ImageURL: "https://t1.daumcdn.net/keditor/emoticon/friends1/large/012.gif"},
+	}
+
+	if len(got.Blocks) != len(want) {
+		t.Fatalf("블록 수 = %d, want %d\n got: %+v", len(got.Blocks), len(want), got.Blocks)
+	}
+	for i, block := range got.Blocks {
+		want[i].Index = i
+		want[i].Position = float64(i) / float64(len(want)-1)
+		if block != want[i] {
+			t.Errorf("Blocks[%d]\n got: %+v\nwant: %+v", i, block, want[i])
+		}
+	}
+
+	if first := got.FirstBlocks(3); len(first) != 3 || first[2].Index != 2 {
+		t.Errorf("FirstBlocks(3) = %+v", first)
+	}
+	if sticker := got.FindNearEnd(structure.BlockTypeSticker, 2); sticker == nil || sticker.Index != 9 {
+		t.Errorf("FindNearEnd(sticker, 2) = %+v, want index 9", sticker)
+	}
+	if image := got.FindNearEnd(structure.BlockTypeImage, 1); image != nil {
+		t.Errorf("FindNearEnd(image, 1) = %+v, want nil", image)
+	}
+	if got.Content == "" {
+		t.Error("Content가 비어 있습니다")
+	}
+}
+
+func TestRegistryFindByDocument(t *testing.T) {
+	registry := NewRegistry(DefaultAdapters()...)
+
+	doc := loadFixture(t, "tistory/poster_custom_domain.html")
+	adapter := registry.FindByDocument(doc)
+	if adapter == nil || adapter.Name() != "tistory" {
+		t.Fatalf("커스텀 도메인 티스토리 문서를 티스토리 어댑터로 판별하지 못했습니다: %v", adapter)
+	}
+
+	doc = loadFixture(t, "tistory/wordpress.html")
+	if adapter := registry.FindByDocument(doc); adapter != nil {
+		t.Errorf("워드프레스 문서가 %s 어댑터로 판별되었습니다", adapter.Name())
+	}
+}
@@ -0,0 +1,81 @@
+package crawler
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// unavailablePageRules는 볼 수 없는 포스트 안내 페이지의 표식과 원인 코드입니다 (확인 순서대로)
+var unavailablePageRules = []struct {
+	code    structure.ErrorCode
+	message string
+	markers []string
+}{
+	{structure.ErrorCodeDeletedPost, "삭제되었거나 존재하지 않는 포스트", constants.DELETED_POST_MARKERS},
+	{structure.ErrorCodeNeighborsOnly, "이웃공개 포스트", constants.NEIGHBORS_ONLY_POST_MARKERS},
+	{structure.ErrorCodePrivatePost, "비공개 포스트", constants.PRIVATE_POST_MARKERS},
+	{structure.ErrorCodeAdultOnly, "성인 인증이 필요한 포스트", constants.ADULT_POST_MARKERS},
+}
+
+// detectUnavailableStatus는 응답 상태 코드가 삭제된 포스트를 의미하는지 확인합니다
+func detectUnavailableStatus(statusCode int) error {
+	if statusCode == http.StatusNotFound || statusCode == http.StatusGone {
+		return structure.NewAnalysisError(structure.ErrorCodeDeletedPost, fmt.Sprintf("삭제되었거나 존재하지 않는 포스트 (HTTP %d)", statusCode), nil)
+	}
+	return nil
+}
+
+// alertMessagePattern은 알림창 스크립트(alert("..."))의 문구를 찾습니다
+var alertMessagePattern = regexp.MustCompile(`alert\(\s*["'](.*?)["']\s*\)`)
+
+// detectUnavailablePage는 페이지가 삭제, 비공개, 이웃공개, 성인 인증 안내 페이지인지 확인합니다
+// 안내 영역(UNAVAILABLE_NOTICE_SELECTOR)의 텍스트와 알림창 스크립트의 문구에서만 표식을 찾으며, 본문이 긴 페이지는 일반 포스트로 간주합니다
+// 짧은 포스트의 본문, 숨겨진 레이어, 다른 스크립트에 포함된 문구("서로이웃만" 등)는 안내 문구로 보지 않습니다
+func detectUnavailablePage(doc *goquery.Document) error {
+	body := doc.Find("body")
+	if body.Length() == 0 {
+		body = doc.Selection
+	}
+	if utf8.RuneCountInString(cleanText(body.Text())) > constants.UNAVAILABLE_PAGE_MAX_TEXT_LENGTH {
+		return nil
+	}
+
+	notices := noticeMessages(doc)
+	for _, rule := range unavailablePageRules {
+		for _, marker := range rule.markers {
+			for _, notice := range notices {
+				if strings.Contains(notice, marker) {
+					return structure.NewAnalysisError(rule.code, fmt.Sprintf("%s (%s)", rule.message, marker), nil)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// noticeMessages는 페이지의 안내 영역 텍스트와 알림창 스크립트 문구를 반환합니다
+func noticeMessages(doc *goquery.Document) []string {
+	var messages []string
+	doc.Find(constants.UNAVAILABLE_NOTICE_SELECTOR).Each(func(_ int, s *goquery.Selection) {
+		messages = append(messages, cleanText(s.Text()))
+	})
+	doc.Find("script").Each(func(_ int, s *goquery.Selection) {
+		for _, match := range alertMessagePattern.FindAllStringSubmatch(s.Text(), -1) {
+			messages = append(messages, match[1])
+		}
+	})
+	return messages
+}
+
+// isUnavailableError는 삭제, 비공개 등으로 다른 주소로 다시 시도해도 볼 수 없는 포스트 에러인지 확인합니다
+func isUnavailableError(err error) bool {
+	return structure.ErrorCodeOf(err).IsUnavailable()
+}
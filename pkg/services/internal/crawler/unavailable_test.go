@@ -0,0 +1,73 @@
+package crawler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestDetectUnavailablePage(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    structure.ErrorCode
+	}{
+		{"unavailable/deleted.html", structure.ErrorCodeDeletedPost},
+		{"unavailable/private.html", structure.ErrorCodePrivatePost},
+		{"unavailable/neighbors_only.html", structure.ErrorCodeNeighborsOnly},
+		{"unavailable/adult.html", structure.ErrorCodeAdultOnly},
+		// 정상 페이지와 차단 페이지는 볼 수 없는 포스트로 판단하지 않음
+		{"naver/se_one.html", ""},
+		{"naver/mobile.html", ""},
+		// 숨겨진 레이어와 스크립트에 공개 범위 문구가 있는 짧은 이미지 위주 포스트
+		{"unavailable/short_image_post.html", ""},
+		{"blocked/captcha.html", ""},
+		{"blocked/empty_frameset.html", ""},
+	}
+
+	for _, tt := range tests {
+		err := detectUnavailablePage(loadFixture(t, tt.fixture))
+		if got := structure.ErrorCodeOf(err); got != tt.want {
+			t.Errorf("detectUnavailablePage(%s) = %q (%v), want %q", tt.fixture, got, err, tt.want)
+		}
+	}
+}
+
+func TestFetchHTMLUnavailable(t *testing.T) {
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+
+	deleted, err := os.ReadFile(filepath.Join("testdata", "unavailable", "deleted.html"))
+	if err != nil {
+		t.Fatalf("픽스처 읽기 실패: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    error
+	}{
+		{"404", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}, structure.ErrDeletedPost},
+		// 알림창만 있는 안내 페이지는 빈 페이지(차단)가 아닌 삭제된 포스트로 판단
+		{"알림창 안내", func(w http.ResponseWriter, r *http.Request) {
+			w.Write(deleted)
+		}, structure.ErrDeletedPost},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(tt.handler)
+		_, err := fetchHTML(server.URL)
+		server.Close()
+
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: fetchHTML 에러 = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package detector
+
+import (
+	"math"
+	"strings"
+
+	constant "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// DetectCommentSponsor는 댓글 목록에서 협찬 여부를 감지합니다
+// 블로그 주인의 댓글은 높은 신뢰도로, 독자의 추측은 낮은 신뢰도로 반영합니다
+// 독자의 질문("혹시 협찬인가요?")과 협찬을 부정하는 댓글("협찬 아니에요")은 근거로 사용하지 않습니다
+// 여러 댓글에서 찾은 근거는 서로 독립적인 근거로 보고 합산하되, 독자 댓글만으로는 협찬으로 판단하지 않습니다
+func DetectCommentSponsor(comments []structure.PostComment) (bool, float64, []structure.SponsorIndicator) {
+	var indicators []structure.SponsorIndicator
+	notSponsoredByOwner, notSponsoredByReader := 1.0, 1.0
+
+	for _, comment := range comments {
+		if isNegatedComment(comment.Text) || (!comment.IsOwner && isQuestionComment(comment.Text)) {
+			continue
+		}
+
+		isSponsored, probability, found := DetectSponsor(comment.Text, structure.SponsorTypeComment)
+		if !isSponsored {
+			continue
+		}
+
+		trust := constant.COMMENT_TRUST_READER
+		if comment.IsOwner {
+			trust = constant.COMMENT_TRUST_OWNER
+		}
+		for i := range found {
+			found[i].Probability *= trust
+		}
+		indicators = append(indicators, found...)
+
+		// 하나라도 협찬을 가리킬 확률로 합산
+		if comment.IsOwner {
+			notSponsoredByOwner *= 1 - math.Min(probability*trust, structure.Accuracy.Absolute)
+		} else {
+			notSponsoredByReader *= 1 - math.Min(probability*trust, structure.Accuracy.Absolute)
+		}
+	}
+
+	// 독자 댓글의 근거는 모두 합쳐도 협찬 판단 기준보다 낮게 제한
+	readerProbability := math.Min(1-notSponsoredByReader, constant.COMMENT_READER_MAX_PROBABILITY)
+
+	// 댓글만으로는 본문 근거보다 높은 확률을 주지 않음
+	probability := math.Min(1-notSponsoredByOwner*(1-readerProbability), structure.Accuracy.Exact)
+	return probability > structure.Accuracy.Possible, probability, indicators
+}
+
+// isQuestionComment는 댓글이 질문인지 확인합니다 (물음표 또는 질문 끝맺음)
+func isQuestionComment(text string) bool {
+	if strings.ContainsAny(text, "?？") {
+		return true
+	}
+
+	trimmed := strings.TrimRight(strings.TrimSpace(text), " ~.!^ㅎㅋㅠㅜ")
+	for _, ending := range constant.COMMENT_QUESTION_ENDINGS {
+		if strings.HasSuffix(trimmed, ending) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNegatedComment는 댓글이 협찬을 부정하는지 확인합니다 (예: "협찬 아니에요, 내돈내산")
+func isNegatedComment(text string) bool {
+	normalized := strings.Join(strings.Fields(text), " ")
+	for _, marker := range constant.COMMENT_NEGATION_MARKERS {
+		if strings.Contains(normalized, marker) {
+			return true
+		}
+	}
+	return false
+}
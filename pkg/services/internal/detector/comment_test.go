@@ -0,0 +1,53 @@
+package detector
+
+import (
+	"testing"
+
+	constant "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+func TestDetectCommentSponsor(t *testing.T) {
+	reader := func(text string) structure.PostComment { return structure.PostComment{Text: text} }
+	owner := func(text string) structure.PostComment { return structure.PostComment{Text: text, IsOwner: true} }
+
+	tests := []struct {
+		name      string
+		comments  []structure.PostComment
+		sponsored bool
+	}{
+		{"주인의 협찬 답글", []structure.PostComment{owner("네 업체로부터 제품을 협찬받아 작성했어요")}, true},
+		{"주인의 협찬 부정", []structure.PostComment{owner("협찬 아니에요, 내돈내산입니다")}, false},
+		{"주인의 협찬 부정 (띄어쓰기)", []structure.PostComment{owner("협찬 안 받았어요 제 돈 주고 샀어요")}, false},
+		{"독자의 질문", []structure.PostComment{reader("혹시 협찬인가요?"), reader("혹시 협찬인가요?")}, false},
+		{"물음표 없는 질문", []structure.PostComment{reader("이거 협찬인가요"), reader("협찬 받으신 건가요ㅎㅎ")}, false},
+		{"독자의 추측 여러 개", []structure.PostComment{reader("협찬이네요"), reader("협찬 티 나요"), reader("광고 협찬 글이네")}, false},
+		{"질문과 주인의 답글", []structure.PostComment{reader("혹시 협찬인가요?"), owner("네 협찬받았습니다")}, true},
+		{"질문과 주인의 부정", []structure.PostComment{reader("혹시 협찬인가요?"), owner("아니요 협찬 아닙니다~")}, false},
+	}
+
+	for _, tt := range tests {
+		sponsored, probability, _ := DetectCommentSponsor(tt.comments)
+		if sponsored != tt.sponsored {
+			t.Errorf("%s: DetectCommentSponsor() = (%v, %.2f), want %v", tt.name, sponsored, probability, tt.sponsored)
+		}
+		if !tt.sponsored && probability > structure.Accuracy.Possible {
+			t.Errorf("%s: 확률 = %.2f, want 협찬 판단 기준 이하", tt.name, probability)
+		}
+	}
+}
+
+func TestDetectCommentSponsorCapsReaderEvidence(t *testing.T) {
+	var comments []structure.PostComment
+	for i := 0; i < 10; i++ {
+		comments = append(comments, structure.PostComment{Text: "업체 협찬 받은 글이네요"})
+	}
+
+	sponsored, probability, indicators := DetectCommentSponsor(comments)
+	if sponsored || probability > constant.COMMENT_READER_MAX_PROBABILITY {
+		t.Errorf("DetectCommentSponsor(독자 댓글 10개) = (%v, %.2f), want 최대 %.2f", sponsored, probability, constant.COMMENT_READER_MAX_PROBABILITY)
+	}
+	if len(indicators) == 0 {
+		t.Errorf("독자 추측 댓글의 근거가 기록되지 않았습니다")
+	}
+}
@@ -0,0 +1,29 @@
+package detector
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// classifyError는 크롤링/OCR 에러의 원인 코드를 판별합니다
+// 원인을 알 수 없는 에러는 fallback 코드를 반환합니다
+func classifyError(err error, fallback structure.ErrorCode) structure.ErrorCode {
+	if code := structure.ErrorCodeOf(err); code != "" {
+		return code
+	}
+
+	var blocked *transport.BlockedError
+	if errors.As(err, &blocked) {
+		return structure.ErrorCodeBlocked
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return structure.ErrorCodeTimeout
+	}
+	return fallback
+}
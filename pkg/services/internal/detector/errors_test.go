@@ -0,0 +1,53 @@
+package detector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// timeoutError는 시간 초과를 나타내는 net.Error입니다
+type timeoutError struct{ timeout bool }
+
+func (e timeoutError) Error() string   { return "i/o timeout" }
+func (e timeoutError) Timeout() bool   { return e.timeout }
+func (e timeoutError) Temporary() bool { return e.timeout }
+
+var _ net.Error = timeoutError{}
+
+func TestClassifyError(t *testing.T) {
+	blocked := &transport.BlockedError{Host: "blog.naver.com", Reason: transport.BlockReasonStatus, Detail: "HTTP 429"}
+
+	tests := []struct {
+		name string
+		err  error
+		want structure.ErrorCode
+	}{
+		{"차단", blocked, structure.ErrorCodeBlocked},
+		{"감싼 차단", fmt.Errorf("요청 실행 실패: %w", blocked), structure.ErrorCodeBlocked},
+		{"net.Error 시간 초과", &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{timeout: true}}, structure.ErrorCodeTimeout},
+		{"시간 초과가 아닌 net.Error", &net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{}}, structure.ErrorCodeCrawlFailed},
+		{"컨텍스트 시간 초과", fmt.Errorf("요청 실행 실패: %w", context.DeadlineExceeded), structure.ErrorCodeTimeout},
+		{"기준 에러", structure.ErrDeletedPost, structure.ErrorCodeDeletedPost},
+		{"감싼 기준 에러", fmt.Errorf("카페 게시글 가져오기 실패: %w", structure.ErrPrivatePost), structure.ErrorCodePrivatePost},
+		{"감싼 AnalysisError", fmt.Errorf("OCR 실패: %w", structure.NewAnalysisError(structure.ErrorCodeOCRBusy, "OCR 대기열 포화", nil)), structure.ErrorCodeOCRBusy},
+		// 코드가 있는 AnalysisError는 원인 에러보다 코드를 우선
+		{"원인이 시간 초과인 AnalysisError", structure.NewAnalysisError(structure.ErrorCodeImageTooLarge, "이미지 크기 초과", context.DeadlineExceeded), structure.ErrorCodeImageTooLarge},
+		{"알 수 없는 에러", errors.New("알 수 없는 에러"), structure.ErrorCodeCrawlFailed},
+	}
+
+	for _, tt := range tests {
+		if got := classifyError(tt.err, structure.ErrorCodeCrawlFailed); got != tt.want {
+			t.Errorf("%s: classifyError(%v) = %s, want %s", tt.name, tt.err, got, tt.want)
+		}
+	}
+
+	if got := classifyError(errors.New("OCR 실패"), structure.ErrorCodeOCRFailed); got != structure.ErrorCodeOCRFailed {
+		t.Errorf("classifyError fallback = %s, want %s", got, structure.ErrorCodeOCRFailed)
+	}
+}
@@ -2,9 +2,10 @@ package detector
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
-	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -12,51 +13,141 @@ import (
 
 	"github.com/sh5080/ndns-go/pkg/configs"
 	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
-	repository "github.com/sh5080/ndns-go/pkg/repositories"
+	"github.com/sh5080/ndns-go/pkg/services/internal/ocr"
+	"github.com/sh5080/ndns-go/pkg/transport"
 	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
 	"github.com/sh5080/ndns-go/pkg/utils"
 )
 
 // OCRImpl는 OCR 서비스 구현체입니다
 type OCRImpl struct {
 	_interface.Service
-	ocrRepo _interface.OCRRepository
+	ocrRepo        _interface.OCRRepository
+	engine         _interface.OCREngine
+	executor       *OCRExecutor
+	cacheKeyPrefix string // 엔진별 캐시 키 접두사 (기본 엔진은 빈 값)
 }
 
-// NewOCRService는 새 OCR 서비스를 생성합니다
-func NewOCRService() _interface.OCRService {
+// NewOCRService는 engine으로 텍스트를 인식하고 결과를 ocrRepo에 캐싱하는 새 OCR 서비스를 생성합니다
+// OCR 엔진은 설정한 작업자 수만큼만 동시에 실행합니다
+func NewOCRService(ocrRepo _interface.OCRRepository, engine _interface.OCREngine) _interface.OCRService {
+	config := configs.GetConfig()
 	return &OCRImpl{
 		Service: _interface.Service{
-			Client: &http.Client{
-				Timeout: time.Second * 30,
-			},
-			Config: configs.GetConfig(),
+			Client: transport.NewClient(time.Second * 30),
+			Config: config,
 		},
-		ocrRepo: repository.NewOCRRepository(),
+		ocrRepo:        ocrRepo,
+		engine:         engine,
+		executor:       NewOCRExecutor(config.OCR.Workers, config.OCR.QueueSize, config.OCR.QueueTimeout),
+		cacheKeyPrefix: ocrCacheKeyPrefix(engine.Name()),
 	}
 }
 
+// ocrCacheKeyPrefix는 엔진의 OCR 캐시 키 접두사를 반환합니다
+// 엔진마다 인식 결과가 다르므로 기본 엔진(tesseract) 외의 엔진은 공유 캐시에서 키를 나눕니다 (가짜 엔진의 결과가 섞이지 않도록)
+func ocrCacheKeyPrefix(engineName string) string {
+	if engineName == string(ocr.EngineTesseract) {
+		return ""
+	}
+	return engineName + ":"
+}
+
 // ExtractTextFromImage는 이미지 URL에서 텍스트를 추출합니다
-func (o *OCRImpl) ExtractTextFromImage(imageURL string) (string, error) {
+// 정규화한 이미지 URL과 다운로드한 이미지 내용(SHA-256)으로 캐시를 조회하여
+// 다른 URL로 제공되는 같은 이미지도 한 번만 OCR합니다 (캐시 키는 엔진별로 나눔)
+// OCR 대기열이 붐비면 낮은 우선순위 요청부터 ErrOCRBusy로 거절합니다
+func (o *OCRImpl) ExtractTextFromImage(imageURL string, priority structure.OCRPriority) (string, error) {
+	urlKey := o.cacheKeyPrefix + utils.CanonicalImageURL(imageURL)
+	if cached := o.getOCRCache(urlKey); cached != nil {
+		return cached.TextDetected, cached.Err()
+	}
+
 	// 동기적으로 처리
-	tempFile, err := o.downloadImage(imageURL)
+	tempFile, contentHash, err := o.downloadImage(imageURL)
 	if err != nil {
+		o.saveOCRCache(newOCRCache("", "", err), urlKey)
 		return "", err
 	}
 	defer os.Remove(tempFile)
 
-	return o.runOCR(context.Background(), tempFile, imageURL)
+	// 내용 해시를 계산하지 못하면 URL 키만 사용
+	keys := []string{urlKey}
+	if contentHash != "" {
+		contentKey := o.cacheKeyPrefix + constants.OCR_CONTENT_HASH_KEY_PREFIX + contentHash
+		if cached := o.getOCRCache(contentKey); cached != nil {
+			// 다른 URL로 이미 처리한 같은 이미지: 다음부터 URL로 바로 찾도록 저장
+			fmt.Printf("OCR 캐시 적중 (같은 이미지 내용): %s\n", imageURL)
+			cached.ImageURL = urlKey
+			o.saveOCRCache(cached, urlKey)
+			return cached.TextDetected, cached.Err()
+		}
+		keys = append(keys, contentKey)
+	}
+
+	text, err := o.runOCR(context.Background(), tempFile, imageURL, priority)
+	o.saveOCRCache(newOCRCache(text, o.engine.Name(), err), keys...)
+	return text, err
+}
+
+// getOCRCache는 key로 캐시된 OCR 결과를 조회합니다 (조회 실패는 캐시 없음으로 처리)
+func (o *OCRImpl) getOCRCache(key string) *structure.OCRCache {
+	cached, err := o.ocrRepo.GetOCRCache(key)
+	if err != nil {
+		fmt.Printf("OCR 캐시 조회 실패 (OCR 진행): %v\n", err)
+		return nil
+	}
+	return cached
 }
 
-// downloadImage는 이미지 URL에서 이미지를 다운로드합니다
-func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
+// newOCRCache는 OCR 결과로 저장할 캐시 항목을 만듭니다 (engine은 결과를 만든 OCR 엔진, 다운로드 단계에서 판단했으면 빈 값)
+// 텍스트가 없거나 GIF인 이미지는 짧은 시간 동안만 보관하고, 일시적인 실패(시간 초과 등)는 저장하지 않습니다 (nil 반환)
+func newOCRCache(text, engine string, err error) *structure.OCRCache {
+	now := time.Now()
+	cache := &structure.OCRCache{
+		TextDetected: text,
+		Engine:       engine,
+		DetectedAt:   now,
+		ExpiresAt:    now.Add(constants.OCR_CACHE_TTL),
+	}
+
+	switch {
+	case err == nil && text != "":
+	case errors.Is(err, structure.ErrOCREmpty) || errors.Is(err, structure.ErrGIFUnsupported):
+		cache.ErrorCode = structure.ErrorCodeOf(err)
+		cache.ExpiresAt = now.Add(constants.OCR_NEGATIVE_CACHE_TTL)
+	default:
+		return nil
+	}
+	return cache
+}
+
+// saveOCRCache는 OCR 결과를 각 캐시 키로 저장합니다 (저장할 결과가 없으면 무시)
+func (o *OCRImpl) saveOCRCache(cache *structure.OCRCache, keys ...string) {
+	if cache == nil {
+		return
+	}
+
+	for _, key := range keys {
+		entry := *cache
+		entry.ImageURL = key
+		if err := o.ocrRepo.SaveOCRCache(entry); err != nil {
+			fmt.Printf("OCR 캐시 저장 실패 (무시됨): %v\n", err)
+		}
+	}
+}
+
+// downloadImage는 이미지 URL에서 이미지를 다운로드하여 임시 파일 경로와 내용 해시를 반환합니다
+// 내용 해시는 크롭 전 원본 이미지의 SHA-256이며, 계산하지 못하면 빈 문자열입니다
+func (o *OCRImpl) downloadImage(imageURL string) (string, string, error) {
 	if !strings.HasPrefix(imageURL, "http://") && !strings.HasPrefix(imageURL, "https://") {
 		imageURL = "https://" + imageURL
 	}
 
 	// GIF 파일 URL 확인 (경로나 쿼리 파라미터에 .gif가 포함되어 있는지)
 	if strings.Contains(strings.ToLower(imageURL), ".gif") {
-		return "", fmt.Errorf("GIF 파일은 OCR 미지원: %s", imageURL)
+		return "", "", structure.NewAnalysisError(structure.ErrorCodeGIFUnsupported, fmt.Sprintf("GIF 파일은 OCR 미지원: %s", imageURL), nil)
 	}
 
 	if !strings.Contains(imageURL, "?type=") && !strings.Contains(imageURL, "&type=") {
@@ -69,8 +160,8 @@ func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
 
 	// 내부 함수: 실제 요청 실행
 	doRequest := func(url string, timeout time.Duration) (*http.Response, error) {
-		// 타임아웃 컨텍스트 생성
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		// 타임아웃 컨텍스트 생성 (요청 경로 전환까지 포함하여 시도 타임아웃의 2배)
+		ctx, cancel := context.WithTimeout(context.Background(), 2*timeout)
 
 		// 응답 객체와 에러를 반환할 변수 선언
 		var resp *http.Response
@@ -82,12 +173,14 @@ func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
 			headReq.Header.Add("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64)")
 
 			// HEAD 요청으로 이미지 크기 확인
-			client := &http.Client{Timeout: 2 * timeout}
+			client := transport.NewClient(timeout)
 			headResp, headErr := client.Do(headReq)
+			if headErr == nil {
+				// 상태 코드와 관계없이 바로 닫아 호스트별 동시 요청 슬롯을 GET 요청 전에 반환
+				headResp.Body.Close()
+			}
 
 			if headErr == nil && headResp.StatusCode == http.StatusOK {
-				defer headResp.Body.Close()
-
 				// Content-Length 헤더로 이미지 크기 확인
 				contentLength := headResp.Header.Get("Content-Length")
 				if contentLength != "" {
@@ -96,7 +189,7 @@ func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
 						const maxSize = 3 * 1024 * 1024
 						if size > maxSize {
 							cancel()
-							return nil, fmt.Errorf("이미지 크기가 너무 큼: %.2f MB (최대 %.2f MB)", float64(size)/1024/1024, float64(maxSize)/1024/1024)
+							return nil, imageTooLargeError(size, maxSize)
 						}
 					}
 				}
@@ -115,9 +208,7 @@ func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
 		req.Header.Add("Accept-Language", "ko-KR,ko;q=0.9,en-US;q=0.8,en;q=0.7")
 
 		// HTTP 클라이언트 생성
-		client := &http.Client{
-			Timeout: (timeout + 2) * timeout,
-		}
+		client := transport.NewClient(timeout)
 
 		resp, err = client.Do(req)
 
@@ -127,9 +218,12 @@ func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
 			return nil, err
 		}
 
-		// 성공적인 응답이 아니면 컨텍스트 취소
+		// 본문을 닫을 때 컨텍스트도 함께 취소
+		resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+		// 성공적인 응답이 아니면 본문을 읽지 않으므로 바로 닫음
 		if resp.StatusCode != http.StatusOK {
-			cancel()
+			resp.Body.Close()
 			return resp, nil
 		}
 
@@ -141,8 +235,7 @@ func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
 				const maxSize = 3 * 1024 * 1024
 				if size > maxSize {
 					resp.Body.Close()
-					cancel()
-					return nil, fmt.Errorf("이미지 크기가 너무 큼: %.2f MB (최대 %.2f MB)", float64(size)/1024/1024, float64(maxSize)/1024/1024)
+					return nil, imageTooLargeError(size, maxSize)
 				}
 			}
 		}
@@ -150,31 +243,24 @@ func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
 		return resp, nil
 	}
 
-	// 1차 시도: 원본 주소 (3초 제한)
+	// 요청 실패 시 워커 프록시 등 다른 요청 경로로의 전환은 transport에서 처리 (경로마다 3초 제한)
 	resp, err := doRequest(imageURL, constants.TIMEOUT)
-
 	if err != nil {
-		// 이미지 크기 관련 오류인 경우 바로 반환
-		if strings.Contains(err.Error(), "이미지 크기가 너무 큼") {
-			return "", err
-		}
-
-		// 그 외 오류는 프록시로 재시도
-		workerURL := configs.GetConfig().Server.WorkerURL + "?url=" + url.QueryEscape(imageURL)
-		resp, err = doRequest(workerURL, constants.TIMEOUT)
-		if err != nil {
-			return "", fmt.Errorf("이미지 요청 실패 (우회 포함): %v", err)
-		}
-		if resp.StatusCode != http.StatusOK {
-			return "", fmt.Errorf("프록시 HTTP 오류 (%d)", resp.StatusCode)
+		// 이미지 크기 관련 오류인 경우 그대로 반환
+		if errors.Is(err, structure.ErrImageTooLarge) {
+			return "", "", err
 		}
+		return "", "", fmt.Errorf("이미지 요청 실패: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("이미지 HTTP 오류 (%d)", resp.StatusCode)
 	}
 
 	// 이미지 파일 크기 제한
 	// 다운로드 후에도 크기를 확인하여 제한 (Content-Length가 없는 경우 대비)
 	tempFilePath, err := utils.SaveResponseToFile(resp, imageURL)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	// 파일 크기 확인
@@ -184,17 +270,23 @@ func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
 		const maxSize = 3 * 1024 * 1024
 		if fileInfo.Size() > maxSize {
 			os.Remove(tempFilePath) // 임시 파일 삭제
-			return "", fmt.Errorf("이미지 크기가 너무 큼: %.2f MB (최대 %.2f MB)", float64(fileInfo.Size())/1024/1024, float64(maxSize)/1024/1024)
+			return "", "", imageTooLargeError(fileInfo.Size(), maxSize)
 		}
 
 		// Content-Type 확인 (다운로드 후)
 		contentType := resp.Header.Get("Content-Type")
 		if strings.Contains(strings.ToLower(contentType), "gif") {
 			os.Remove(tempFilePath) // 임시 파일 삭제
-			return "", fmt.Errorf("GIF 파일은 OCR 미지원: %s (Content-Type: %s)", imageURL, contentType)
+			return "", "", structure.NewAnalysisError(structure.ErrorCodeGIFUnsupported, fmt.Sprintf("GIF 파일은 OCR 미지원: %s (Content-Type: %s)", imageURL, contentType), nil)
 		}
 	}
 
+	// 같은 이미지를 다른 URL로 처리한 결과를 찾기 위한 내용 해시 (크롭 전 원본 기준)
+	contentHash, err := utils.HashImageFile(tempFilePath)
+	if err != nil {
+		fmt.Printf("이미지 해시 계산 실패 (계속 진행): %v\n", err)
+	}
+
 	// 이미지 차원(가로/세로) 확인
 	dimensions, err := utils.GetImageDimensions(tempFilePath)
 	if err == nil {
@@ -214,39 +306,78 @@ func (o *OCRImpl) downloadImage(imageURL string) (string, error) {
 		fmt.Printf("이미지 차원 확인 실패 (계속 진행): %v\n", err)
 	}
 
-	return tempFilePath, nil
+	return tempFilePath, contentHash, nil
+}
+
+// imageTooLargeError는 OCR 크기 제한을 넘는 이미지 에러를 생성합니다
+func imageTooLargeError(size, maxSize int64) error {
+	message := fmt.Sprintf("이미지 크기가 너무 큼: %.2f MB (최대 %.2f MB)", float64(size)/1024/1024, float64(maxSize)/1024/1024)
+	return structure.NewAnalysisError(structure.ErrorCodeImageTooLarge, message, nil)
+}
+
+// cancelOnClose는 응답 본문을 닫을 때 요청 컨텍스트를 취소하는 ReadCloser입니다
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
 }
 
-// runOCR은 Tesseract를 사용하여 OCR 처리를 수행합니다
-func (o *OCRImpl) runOCR(ctx context.Context, imagePath string, imageURL string) (string, error) {
+// Close는 본문을 닫고 컨텍스트를 취소합니다
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
+
+// runOCR은 OCR 엔진을 사용하여 OCR 처리를 수행합니다
+// 엔진은 OCR 실행기의 작업자가 비었을 때 실행하며, 처리 시간 제한은 실행을 시작한 뒤부터 적용합니다
+func (o *OCRImpl) runOCR(ctx context.Context, imagePath string, imageURL string, priority structure.OCRPriority) (string, error) {
 	// OCR 디버깅용
 	fmt.Printf("OCR 실행 시작 - 이미지 경로: %s\n", imagePath)
 	startTime := time.Now()
-	serviceType := "tesseract_ocr"
+	serviceType := o.engine.Name() + "_ocr"
 
 	// 이미지 형식 확인 - 파일 시그니처 체크
 	if utils.IsGifImage(imagePath) {
 		fmt.Printf("GIF 이미지 감지됨: %s - OCR 처리 건너뜀\n", imagePath)
-		return "[GIF 파일은 OCR 미지원]", nil
+		return "", structure.NewAnalysisError(structure.ErrorCodeGIFUnsupported, fmt.Sprintf("GIF 파일은 OCR 미지원: %s", imageURL), nil)
 	}
 
-	// OCR 처리를 위한 타임아웃 컨텍스트
-	ocrCtx, cancel := context.WithTimeout(ctx, constants.TIMEOUT)
-	defer cancel()
+	// OCR 엔진으로 텍스트 인식 (작업자가 빌 때까지 대기열에서 기다림)
+	textDetected, err := o.executor.Run(ctx, priority, func(ctx context.Context) (string, error) {
+		// 대기 시간을 제외하고 실행 시간만 측정
+		startTime = time.Now()
+
+		// OCR 처리를 위한 타임아웃 컨텍스트
+		ocrCtx, cancel := context.WithTimeout(ctx, constants.TIMEOUT)
+		defer cancel()
+		return o.engine.Recognize(ocrCtx, imagePath)
+	})
+
+	switch {
+	case err == nil:
+	case structure.ErrorCodeOf(err) != "":
+		// 대기열 포화 등 실행기에서 거절된 경우
+		fmt.Printf("OCR 실행 대기 실패: %v [이미지: %s]\n", err, imageURL)
+		utils.RecordError(serviceType, string(structure.ErrorCodeOf(err)))
+		return "", err
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled):
+		fmt.Printf("OCR 컨텍스트 취소됨: %v\n", err)
+		utils.RecordError(serviceType, "context_deadline_exceeded")
+		utils.Error(serviceType, "OCR 처리 중 컨텍스트 취소됨: %v [이미지: %s]", err, imageURL)
 
-	// 향상된 OCR 처리 사용 (여러 PSM 모드와 이미지 전처리 적용)
-	textDetected := utils.RunTesseractWithContext(ocrCtx, imagePath)
+		// OCR 오류 로그 데이터 저장
+		utils.OCRErrorLog("CONTEXT_DEADLINE_EXCEEDED", imageURL, err.Error())
 
-	// 컨텍스트 취소 확인 (상위 컨텍스트의 취소 여부만 확인)
-	if ctx.Err() != nil {
-		fmt.Printf("상위 컨텍스트 취소됨: %v\n", ctx.Err())
-		utils.RecordError(serviceType, "context_deadline_exceeded")
-		utils.Error(serviceType, "Tesseract OCR 처리 중 컨텍스트 취소됨: %v [이미지: %s]", ctx.Err(), imageURL)
+		return "", structure.NewAnalysisError(structure.ErrorCodeTimeout, "OCR 처리 시간 초과", err)
+	default:
+		fmt.Printf("OCR 엔진 오류 (%s): %v\n", o.engine.Name(), err)
+		utils.RecordError(serviceType, "engine_error")
+		utils.Error(serviceType, "OCR 엔진 오류: %v [이미지: %s]", err, imageURL)
 
 		// OCR 오류 로그 데이터 저장
-		utils.OCRErrorLog("CONTEXT_DEADLINE_EXCEEDED", imageURL, ctx.Err().Error())
+		utils.OCRErrorLog("ENGINE_ERROR", imageURL, err.Error())
 
-		return "context deadline exceeded", nil
+		return "", structure.NewAnalysisError(structure.ErrorCodeOCRFailed, "OCR 엔진 오류", err)
 	}
 
 	// 텍스트가 없는 경우
@@ -258,7 +389,7 @@ func (o *OCRImpl) runOCR(ctx context.Context, imagePath string, imageURL string)
 		// OCR 오류 로그 데이터 저장
 		utils.OCRErrorLog("NO_TEXT_DETECTED", imageURL, "텍스트 추출 실패")
 
-		return "[OCR 인식 불가: 이미지에서 텍스트 추출 실패]", nil
+		return "", structure.NewAnalysisError(structure.ErrorCodeOCREmpty, "OCR 인식 불가: 이미지에서 텍스트 추출 실패", nil)
 	}
 
 	// OCR 처리 시간 측정 완료
@@ -0,0 +1,194 @@
+package detector
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+	"github.com/sh5080/ndns-go/pkg/utils"
+)
+
+// OCRExecutor는 정해진 수의 작업자로 OCR 엔진을 실행하는 OCR 실행기입니다
+// 작업자가 모두 바쁘면 요청은 우선순위별 대기열에서 기다리며, 높은 우선순위 요청을 먼저 처리합니다
+// 대기열이 가득 차거나 오래 기다린 요청은 ErrOCRBusy로 거절합니다
+type OCRExecutor struct {
+	queues    [2][]*ocrJob // 우선순위별 대기열 (OCRPriorityHigh, OCRPriorityLow 순)
+	workers   int
+	busy      int // 요청을 실행 중인 작업자 수
+	queueSize int
+	maxWait   time.Duration
+	lock      sync.Mutex
+	ready     *sync.Cond
+}
+
+// ocrJob은 대기열에서 작업자를 기다리는 OCR 요청입니다
+type ocrJob struct {
+	ctx        context.Context
+	priority   structure.OCRPriority
+	run        func(ctx context.Context) (string, error)
+	enqueuedAt time.Time
+	done       chan ocrResult
+}
+
+// ocrResult는 작업자가 실행한 OCR 결과입니다
+type ocrResult struct {
+	text string
+	err  error
+}
+
+// NewOCRExecutor는 workers개의 작업자와 queueSize 크기의 대기열을 가진 OCR 실행기를 생성합니다
+// workers가 0 이하면 CPU 코어 수를 사용하고, maxWait가 0 이하면 대기 시간을 제한하지 않습니다
+func NewOCRExecutor(workers, queueSize int, maxWait time.Duration) *OCRExecutor {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	executor := &OCRExecutor{
+		workers:   workers,
+		queueSize: queueSize,
+		maxWait:   maxWait,
+	}
+	executor.ready = sync.NewCond(&executor.lock)
+
+	for i := 0; i < workers; i++ {
+		go executor.work()
+	}
+	fmt.Printf("OCR 실행기 시작: 작업자 %d개, 대기열 %d개\n", workers, queueSize)
+	return executor
+}
+
+// Run은 작업자가 비면 run을 실행하고 결과를 반환합니다
+// run은 작업자가 실행을 시작할 때 ctx를 받으므로, 처리 시간 제한은 대기 시간과 별도로 run 안에서 적용합니다
+// 알 수 없는 우선순위는 낮은 우선순위로 처리합니다
+func (e *OCRExecutor) Run(ctx context.Context, priority structure.OCRPriority, run func(ctx context.Context) (string, error)) (string, error) {
+	if priority != structure.OCRPriorityHigh {
+		priority = structure.OCRPriorityLow
+	}
+
+	job := &ocrJob{
+		ctx:        ctx,
+		priority:   priority,
+		run:        run,
+		enqueuedAt: time.Now(),
+		done:       make(chan ocrResult, 1),
+	}
+
+	if err := e.enqueue(job); err != nil {
+		return "", err
+	}
+
+	var timeout <-chan time.Time
+	if e.maxWait > 0 {
+		timer := time.NewTimer(e.maxWait)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case result := <-job.done:
+		return result.text, result.err
+	case <-timeout:
+	case <-ctx.Done():
+	}
+
+	// 아직 대기 중이면 대기열에서 빼고 거절, 이미 작업자가 실행 중이면 결과를 기다림
+	if !e.remove(job) {
+		result := <-job.done
+		return result.text, result.err
+	}
+	if ctx.Err() != nil {
+		return "", structure.NewAnalysisError(structure.ErrorCodeTimeout, "OCR 대기 중 취소됨", ctx.Err())
+	}
+	utils.RecordOCRRejected(priority.String(), "wait_timeout")
+	return "", structure.NewAnalysisError(structure.ErrorCodeOCRBusy, fmt.Sprintf("OCR 대기 시간 초과 (%v)", e.maxWait), nil)
+}
+
+// enqueue는 요청을 대기열에 넣고 쉬고 있는 작업자를 깨웁니다
+// 모든 작업자가 바쁠 때는 대기열 크기만큼만 받으며, 낮은 우선순위 요청은 대기열 일부만 사용할 수 있어 먼저 거절됩니다
+func (e *OCRExecutor) enqueue(job *ocrJob) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	limit := e.queueSize
+	if job.priority != structure.OCRPriorityHigh {
+		limit = int(float64(e.queueSize) * constants.OCR_LOW_PRIORITY_QUEUE_SHARE)
+	}
+
+	// 쉬는 작업자가 바로 가져갈 요청을 빼고, 작업자를 기다려야 하는 요청 수로 판단
+	depth := e.depth()
+	if waiting := depth + e.busy - e.workers; waiting >= limit {
+		utils.RecordOCRRejected(job.priority.String(), "queue_full")
+		return structure.NewAnalysisError(structure.ErrorCodeOCRBusy, fmt.Sprintf("OCR 대기열 포화 (대기 %d개)", depth), nil)
+	}
+
+	e.queues[job.priority] = append(e.queues[job.priority], job)
+	utils.RecordOCRQueueDepth(job.priority.String(), len(e.queues[job.priority]))
+	e.ready.Signal()
+	return nil
+}
+
+// remove는 아직 작업자가 가져가지 않은 요청을 대기열에서 뺍니다 (뺐으면 true)
+func (e *OCRExecutor) remove(job *ocrJob) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	queue := e.queues[job.priority]
+	for i, queued := range queue {
+		if queued == job {
+			e.queues[job.priority] = append(queue[:i], queue[i+1:]...)
+			utils.RecordOCRQueueDepth(job.priority.String(), len(e.queues[job.priority]))
+			return true
+		}
+	}
+	return false
+}
+
+// depth는 대기 중인 요청 수를 반환합니다 (lock을 잡은 상태에서 호출)
+func (e *OCRExecutor) depth() int {
+	total := 0
+	for _, queue := range e.queues {
+		total += len(queue)
+	}
+	return total
+}
+
+// next는 가장 높은 우선순위의 요청을 대기열에서 꺼냅니다 (lock을 잡은 상태에서 호출, 없으면 nil)
+func (e *OCRExecutor) next() *ocrJob {
+	for priority, queue := range e.queues {
+		if len(queue) == 0 {
+			continue
+		}
+		job := queue[0]
+		queue[0] = nil
+		e.queues[priority] = queue[1:]
+		utils.RecordOCRQueueDepth(job.priority.String(), len(e.queues[priority]))
+		return job
+	}
+	return nil
+}
+
+// work는 대기열에서 요청을 꺼내 하나씩 실행하는 작업자입니다
+func (e *OCRExecutor) work() {
+	for {
+		e.lock.Lock()
+		job := e.next()
+		for job == nil {
+			e.ready.Wait()
+			job = e.next()
+		}
+		e.busy++
+		e.lock.Unlock()
+
+		utils.RecordOCRQueueWait(job.priority.String(), time.Since(job.enqueuedAt).Seconds())
+		text, err := job.run(job.ctx)
+
+		e.lock.Lock()
+		e.busy--
+		e.lock.Unlock()
+		job.done <- ocrResult{text: text, err: err}
+	}
+}
@@ -0,0 +1,145 @@
+package detector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// occupyWorker는 release가 닫힐 때까지 작업자 하나를 붙잡아 두는 요청을 실행합니다
+func occupyWorker(t *testing.T, e *OCRExecutor) (release func()) {
+	t.Helper()
+	started := make(chan struct{})
+	done := make(chan struct{})
+	go e.Run(context.Background(), structure.OCRPriorityHigh, func(ctx context.Context) (string, error) {
+		close(started)
+		<-done
+		return "", nil
+	})
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("작업자가 요청을 시작하지 않음")
+	}
+	return func() { close(done) }
+}
+
+// waitForDepth는 대기 중인 요청 수가 want가 될 때까지 기다립니다
+func waitForDepth(t *testing.T, e *OCRExecutor, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		e.lock.Lock()
+		depth := e.depth()
+		e.lock.Unlock()
+		if depth == want {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("대기열 길이가 %d가 되지 않음", want)
+}
+
+func TestOCRExecutorServesHighPriorityFirst(t *testing.T) {
+	executor := NewOCRExecutor(1, 4, 0)
+	release := occupyWorker(t, executor)
+
+	order := make(chan string, 2)
+	record := func(name string) func(ctx context.Context) (string, error) {
+		return func(ctx context.Context) (string, error) {
+			order <- name
+			return name, nil
+		}
+	}
+
+	// 낮은 우선순위 요청이 먼저 대기열에 들어가도 높은 우선순위 요청을 먼저 처리
+	go executor.Run(context.Background(), structure.OCRPriorityLow, record("low"))
+	waitForDepth(t, executor, 1)
+	go executor.Run(context.Background(), structure.OCRPriorityHigh, record("high"))
+	waitForDepth(t, executor, 2)
+	release()
+
+	for _, want := range []string{"high", "low"} {
+		select {
+		case got := <-order:
+			if got != want {
+				t.Fatalf("처리 순서 = %s, want %s", got, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%s 요청이 처리되지 않음", want)
+		}
+	}
+}
+
+func TestOCRExecutorRejectsWhenQueueFull(t *testing.T) {
+	executor := NewOCRExecutor(1, 2, 0)
+	release := occupyWorker(t, executor)
+	defer release()
+
+	wait := func(ctx context.Context) (string, error) { return "", nil }
+
+	// 낮은 우선순위는 대기열의 OCR_LOW_PRIORITY_QUEUE_SHARE(절반)까지만 사용
+	go executor.Run(context.Background(), structure.OCRPriorityLow, wait)
+	waitForDepth(t, executor, 1)
+	if _, err := executor.Run(context.Background(), structure.OCRPriorityLow, wait); structure.ErrorCodeOf(err) != structure.ErrorCodeOCRBusy {
+		t.Fatalf("낮은 우선순위 초과 요청 에러 = %v, want %s", err, structure.ErrorCodeOCRBusy)
+	}
+
+	// 높은 우선순위는 남은 대기열을 사용하고, 대기열이 가득 차면 거절
+	go executor.Run(context.Background(), structure.OCRPriorityHigh, wait)
+	waitForDepth(t, executor, 2)
+	if _, err := executor.Run(context.Background(), structure.OCRPriorityHigh, wait); structure.ErrorCodeOf(err) != structure.ErrorCodeOCRBusy {
+		t.Fatalf("대기열 포화 요청 에러 = %v, want %s", err, structure.ErrorCodeOCRBusy)
+	}
+}
+
+func TestOCRExecutorWaitTimeoutRemovesJob(t *testing.T) {
+	executor := NewOCRExecutor(1, 2, 20*time.Millisecond)
+	release := occupyWorker(t, executor)
+
+	ran := make(chan struct{}, 1)
+	_, err := executor.Run(context.Background(), structure.OCRPriorityHigh, func(ctx context.Context) (string, error) {
+		ran <- struct{}{}
+		return "", nil
+	})
+	if structure.ErrorCodeOf(err) != structure.ErrorCodeOCRBusy {
+		t.Fatalf("대기 시간 초과 에러 = %v, want %s", err, structure.ErrorCodeOCRBusy)
+	}
+	waitForDepth(t, executor, 0)
+
+	// 거절한 요청은 작업자가 비어도 실행하지 않음
+	release()
+	select {
+	case <-ran:
+		t.Fatal("대기 시간 초과로 거절한 요청이 실행됨")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestOCRExecutorWaitsForPickedUpJob(t *testing.T) {
+	executor := NewOCRExecutor(1, 2, 20*time.Millisecond)
+
+	// 대기 시간이 지나도 작업자가 이미 실행 중인 요청은 실제 결과를 반환
+	text, err := executor.Run(context.Background(), structure.OCRPriorityHigh, func(ctx context.Context) (string, error) {
+		time.Sleep(80 * time.Millisecond)
+		return "협찬", nil
+	})
+	if err != nil || text != "협찬" {
+		t.Fatalf("Run = %q, %v, want 협찬", text, err)
+	}
+}
+
+func TestOCRExecutorUnknownPriority(t *testing.T) {
+	executor := NewOCRExecutor(1, 2, time.Second)
+
+	// 범위를 벗어난 우선순위는 낮은 우선순위로 처리
+	text, err := executor.Run(context.Background(), structure.OCRPriority(7), func(ctx context.Context) (string, error) {
+		return "협찬", nil
+	})
+	if err != nil || text != "협찬" {
+		t.Fatalf("Run = %q, %v, want 협찬", text, err)
+	}
+}
@@ -0,0 +1,110 @@
+package detector
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+	repository "github.com/sh5080/ndns-go/pkg/repositories"
+	"github.com/sh5080/ndns-go/pkg/services/internal/ocr"
+	"github.com/sh5080/ndns-go/pkg/transport"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+	"github.com/sh5080/ndns-go/pkg/utils"
+)
+
+// fixedEngine은 항상 같은 텍스트를 반환하는 OCR 엔진입니다
+type fixedEngine struct {
+	name  string
+	text  string
+	calls int
+}
+
+func (e *fixedEngine) Name() string {
+	return e.name
+}
+
+func (e *fixedEngine) Recognize(ctx context.Context, imagePath string) (string, error) {
+	e.calls++
+	return e.text, nil
+}
+
+// newTestOCRService는 설정 없이 engine으로 인식하고 ocrRepo에 캐싱하는 OCR 서비스를 생성합니다
+func newTestOCRService(ocrRepo _interface.OCRRepository, engine _interface.OCREngine) *OCRImpl {
+	return &OCRImpl{
+		ocrRepo:        ocrRepo,
+		engine:         engine,
+		executor:       NewOCRExecutor(1, 1, time.Second),
+		cacheKeyPrefix: ocrCacheKeyPrefix(engine.Name()),
+	}
+}
+
+func TestOCRCacheIsNamespacedByEngine(t *testing.T) {
+	t.Chdir(t.TempDir()) // OCR 오류 로그 파일을 임시 디렉토리에 기록
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte("\x89PNG\r\n\x1a\nimage"))
+	}))
+	defer server.Close()
+	imageURL := server.URL + "/image.png"
+
+	// 가짜 엔진의 빈 결과는 가짜 엔진 키로만 저장
+	ocrRepo := repository.NewMemoryOCRRepository(100)
+	fake := newTestOCRService(ocrRepo, ocr.NewFakeEngine(""))
+	if _, err := fake.ExtractTextFromImage(imageURL, structure.OCRPriorityHigh); !errors.Is(err, structure.ErrOCREmpty) {
+		t.Fatalf("가짜 엔진 ExtractTextFromImage 에러 = %v, want ErrOCREmpty", err)
+	}
+	if cached, _ := ocrRepo.GetOCRCache("fake:" + utils.CanonicalImageURL(imageURL)); cached == nil {
+		t.Errorf("가짜 엔진 결과가 가짜 엔진 키로 저장되지 않았습니다")
+	}
+
+	// 같은 캐시를 쓰는 기본 엔진은 가짜 엔진 결과를 사용하지 않고 직접 인식
+	engine := &fixedEngine{name: string(ocr.EngineTesseract), text: "업체로부터 제품을 제공받았습니다"}
+	service := newTestOCRService(ocrRepo, engine)
+	text, err := service.ExtractTextFromImage(imageURL, structure.OCRPriorityHigh)
+	if err != nil || text != engine.text || engine.calls != 1 {
+		t.Errorf("ExtractTextFromImage = %q, %v (엔진 호출 %d번), want %q", text, err, engine.calls, engine.text)
+	}
+	if cached, _ := ocrRepo.GetOCRCache(utils.CanonicalImageURL(imageURL)); cached == nil || cached.TextDetected != engine.text {
+		t.Errorf("기본 엔진 결과가 기존 키로 저장되지 않았습니다: %+v", cached)
+	}
+}
+
+func TestOCRCacheHitsSameImageContent(t *testing.T) {
+	t.Chdir(t.TempDir()) // OCR 오류 로그 파일을 임시 디렉토리에 기록
+	transport.SetDefaultTransport(http.DefaultTransport)
+	defer transport.SetDefaultTransport(nil)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte("\x89PNG\r\n\x1a\nimage"))
+	}))
+	defer server.Close()
+	firstURL := server.URL + "/first.png"
+	secondURL := server.URL + "/second.png"
+
+	ocrRepo := repository.NewMemoryOCRRepository(100)
+	engine := &fixedEngine{name: string(ocr.EngineTesseract), text: "업체로부터 제품을 제공받았습니다"}
+	service := newTestOCRService(ocrRepo, engine)
+
+	if _, err := service.ExtractTextFromImage(firstURL, structure.OCRPriorityHigh); err != nil {
+		t.Fatalf("첫 번째 URL ExtractTextFromImage 에러: %v", err)
+	}
+
+	// 다른 URL로 받은 같은 내용의 이미지는 다시 인식하지 않고 내용 해시 캐시를 사용
+	text, err := service.ExtractTextFromImage(secondURL, structure.OCRPriorityHigh)
+	if err != nil || text != engine.text || engine.calls != 1 {
+		t.Errorf("두 번째 URL ExtractTextFromImage = %q, %v (엔진 호출 %d번), want %q (엔진 호출 1번)", text, err, engine.calls, engine.text)
+	}
+
+	// 내용 해시로 찾은 결과는 두 번째 URL 키로도 저장하여 다음에는 다운로드 없이 찾음
+	if cached, _ := ocrRepo.GetOCRCache(utils.CanonicalImageURL(secondURL)); cached == nil || cached.TextDetected != engine.text {
+		t.Errorf("내용 해시 캐시 적중 결과가 URL 키로 저장되지 않았습니다: %+v", cached)
+	}
+}
@@ -1,8 +1,8 @@
 package detector
 
 import (
+	"errors"
 	"fmt"
-	"net/http"
 	"regexp"
 	"strings"
 	"sync"
@@ -11,7 +11,7 @@ import (
 	"github.com/sh5080/ndns-go/pkg/configs"
 	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
 	"github.com/sh5080/ndns-go/pkg/services/internal/analyzer"
-	"github.com/sh5080/ndns-go/pkg/services/internal/crawler"
+	"github.com/sh5080/ndns-go/pkg/transport"
 	constant "github.com/sh5080/ndns-go/pkg/types"
 	structure "github.com/sh5080/ndns-go/pkg/types/structures"
 	"github.com/sh5080/ndns-go/pkg/utils"
@@ -20,39 +20,40 @@ import (
 // PostImpl는 포스트 감지 서비스 구현체입니다
 type PostImpl struct {
 	_interface.Service
-	ocrService _interface.OCRService
+	ocrService     _interface.OCRService
+	crawlerService _interface.CrawlerService
+	analysisRepo   _interface.AnalysisRepository
 }
 
 // NewPostService는 새 포스트 감지 서비스를 생성합니다
-func NewPostService(ocrService _interface.OCRService) _interface.PostService {
+func NewPostService(
+	ocrService _interface.OCRService,
+	crawlerService _interface.CrawlerService,
+	analysisRepo _interface.AnalysisRepository,
+) _interface.PostService {
 	return &PostImpl{
 		Service: _interface.Service{
-			Client: &http.Client{
-				Timeout: time.Second * 30,
-			},
+			Client: transport.NewClient(time.Second * 30),
 			Config: configs.GetConfig(),
 		},
-		ocrService: ocrService,
+		ocrService:     ocrService,
+		crawlerService: crawlerService,
+		analysisRepo:   analysisRepo,
 	}
 }
 
 // OCR 처리 공통 함수
-func (s *PostImpl) processOCR(url string, sourceType structure.SponsorType) (bool, float64, []structure.SponsorIndicator, string) {
+// priority는 OCR 대기열에서의 처리 순서입니다 (첫 이미지/스티커는 높음, 마지막 이미지/스티커는 낮음)
+func (s *PostImpl) processOCR(url string, sourceType structure.SponsorType, priority structure.OCRPriority) (bool, float64, []structure.SponsorIndicator, error) {
 	// URL이 비어있으면 처리 건너뜀
 	if url == "" {
-		return false, 0, nil, ""
+		return false, 0, nil, nil
 	}
 
-	ocrText, err := s.ocrService.ExtractTextFromImage(url)
-
+	ocrText, err := s.ocrService.ExtractTextFromImage(url, priority)
 	if err != nil {
-		errMsg := fmt.Sprintf("OCR 처리 오류: %s", err.Error())
 		utils.DebugLog("OCR 오류: %s\n", err.Error())
-		return false, 0, nil, errMsg
-	}
-
-	if strings.Contains(ocrText, "context deadline exceeded") || strings.Contains(ocrText, "Get \"") {
-		return false, 0, nil, ocrText
+		return false, 0, nil, err
 	}
 
 	trimmedText := strings.TrimSpace(ocrText)
@@ -66,22 +67,39 @@ func (s *PostImpl) processOCR(url string, sourceType structure.SponsorType) (boo
 		// 1. 먼저 한글 텍스트가 있는지 확인
 		if hangulRegex.MatchString(trimmedText) {
 			isSponsored, probability, indicators := DetectSponsor(trimmedText, sourceType)
-			return isSponsored, probability, indicators, ""
+			return isSponsored, probability, indicators, nil
 		}
 
 		// 3. 위 조건에 모두 해당하지 않고 텍스트가 너무 짧은 경우
 		if textLength < 10 {
 			utils.DebugLog("스티커 OCR 텍스트가 너무 짧고 의미 없음 (%d자): %s\n", textLength, trimmedText)
-			return false, 0, nil, "OCR_TEXT_TOO_SHORT"
+			return false, 0, nil, structure.NewAnalysisError(structure.ErrorCodeOCREmpty, fmt.Sprintf("스티커 OCR 텍스트가 너무 짧음 (%d자)", textLength), nil)
 		}
 	}
 
 	// 일반적인 경우 처리
 	isSponsored, probability, indicators := DetectSponsor(ocrText, sourceType)
-	return isSponsored, probability, indicators, ""
+	return isSponsored, probability, indicators, nil
+}
+
+// applyOCRResult는 OCR 분석 결과를 포스트에 반영합니다
+// 텍스트가 없거나 GIF인 이미지, OCR 대기열이 가득 차 건너뛴 이미지는 원인 코드만 기록하고 다음 단계 분석을 계속합니다
+func applyOCRResult(blogPost *structure.BlogPost, isSponsored bool, probability float64, indicators []structure.SponsorIndicator, err error) {
+	switch {
+	case err == nil:
+		if isSponsored {
+			analyzer.UpdateBlogPostWithSponsorInfo(blogPost, isSponsored, probability, indicators)
+		}
+	case errors.Is(err, structure.ErrOCREmpty) || errors.Is(err, structure.ErrGIFUnsupported) || errors.Is(err, structure.ErrOCRBusy):
+		analyzer.AddErrorCode(blogPost, classifyError(err, structure.ErrorCodeOCRFailed))
+	default:
+		analyzer.UpdateBlogPostWithSponsorInfo(blogPost, false, 0, nil, fmt.Sprintf("OCR 처리 오류: %v", err))
+		analyzer.AddErrorCode(blogPost, classifyError(err, structure.ErrorCodeOCRFailed))
+	}
 }
 
 // DetectPosts는 여러 포스트에서 동시에 협찬 관련 텍스트를 탐지합니다
+// 같은 포스트(PostID 기준)가 여러 번 포함된 경우 한 번만 분석합니다
 func (s *PostImpl) DetectPosts(posts []structure.NaverSearchItem) ([]structure.BlogPost, error) {
 	// 결과를 저장할 슬라이스 초기화
 	results := make([]structure.BlogPost, len(posts))
@@ -91,6 +109,22 @@ func (s *PostImpl) DetectPosts(posts []structure.NaverSearchItem) ([]structure.B
 		results[i] = analyzer.CreateBlogPost(post)
 	}
 
+	// 중복 포스트 확인 (중복 인덱스 -> 처음 등장한 인덱스)
+	originIndex := make(map[int]int)
+	firstIndexByKey := make(map[string]int)
+	for i, post := range results {
+		if post.PostID == nil {
+			continue
+		}
+
+		key := post.PostID.Key()
+		if first, exists := firstIndexByKey[key]; exists {
+			originIndex[i] = first
+			continue
+		}
+		firstIndexByKey[key] = i
+	}
+
 	// 동시성 제어를 위한 WaitGroup
 	var wg sync.WaitGroup
 
@@ -99,233 +133,272 @@ func (s *PostImpl) DetectPosts(posts []structure.NaverSearchItem) ([]structure.B
 
 	// 각 포스트에 대해 병렬로 처리
 	for i, post := range posts {
+		// 중복 포스트는 처음 등장한 포스트의 분석 결과를 사용
+		if _, duplicated := originIndex[i]; duplicated {
+			continue
+		}
+
 		wg.Add(1)
 
 		// 고루틴으로 포스트 분석
 		go func(index int, item structure.NaverSearchItem) {
 			defer wg.Done()
 
-			utils.DebugLog("포스트 날짜: %v\n", item.PostDate)
-			// 2025년 이후 포스트인지 확인
-			is2025OrLater := utils.IsAfter2025(item.PostDate)
+			blogPost := s.analyzePost(index, item)
 
-			// 블로그 포스트 초기화 (analyzer 패키지 사용)
-			blogPost := analyzer.CreateBlogPost(item)
+			// 결과 저장
+			mu.Lock()
+			results[index] = blogPost
+			mu.Unlock()
+		}(i, post)
+	}
 
-			// 1. Description 텍스트 탐지 수행
-			isSponsored, probability, indicators := DetectSponsor(item.Description, structure.SponsorTypeDescription)
+	// 모든 고루틴이 완료될 때까지 대기
+	wg.Wait()
 
-			if isSponsored {
-				// 공통 함수 사용하여 스폰서 정보 업데이트
-				analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
-			} else {
-				// 2. Description에서 스폰서 탐지 실패시 본문 크롤링
-				crawlResult, err := crawler.CrawlBlogPost(item.Link, is2025OrLater)
-				if err != nil {
-					fmt.Printf("[%d] 크롤링 실패: %v\n", index, err)
-					// 크롤링 실패 시 에러 메시지 저장하고 결과 반환
-					blogPost.Error = fmt.Sprintf("크롤링 실패: %v", err)
-					mu.Lock()
-					results[index] = blogPost
-					mu.Unlock()
-					return
-				}
+	// 중복 포스트에 분석 결과 복사
+	for index, origin := range originIndex {
+		results[index] = analyzer.CopyAnalysis(results[origin], posts[index])
+	}
 
-				// crawlResult가 nil인 경우 처리
-				if crawlResult == nil {
-					blogPost.Error = "크롤링 결과가 없습니다"
-					mu.Lock()
-					results[index] = blogPost
-					mu.Unlock()
-					return
-				}
+	return results, nil
+}
 
-				// 본문 분석 순서:
-				// 1. 첫 이미지/스티커 URL 도메인 확인
-				// 2. 도메인이 협찬이 아니면 첫 이미지/스티커 OCR 분석
-				// 3. 첫 문단 분석
-				// 4. 2025년 이전 포스트만: 마지막 문단/스티커/이미지 분석
-
-				// 1. 첫 번째 이미지 URL과 스티커 URL 도메인 확인
-				foundSponsorDomain := false
-				var foundURL, domain string
-				sponsorType := structure.SponsorTypeImage
-
-				// 1-1. 첫 번째 이미지 URL 확인
-				utils.DebugLog("1-1. 첫 번째 이미지 URL 확인\n")
-				if foundDomain, matchedDomain := analyzer.CheckSponsorDomain(crawlResult.FirstImageURL, constant.SPONSOR_DOMAINS); foundDomain {
-					foundSponsorDomain = true
-					foundURL = crawlResult.FirstImageURL
-					sponsorType = structure.SponsorTypeImage
-					domain = matchedDomain
-				}
+// analyzePost는 저장된 분석 결과가 있으면 재사용하고, 없으면 포스트를 분석한 뒤 저장합니다
+func (s *PostImpl) analyzePost(index int, item structure.NaverSearchItem) structure.BlogPost {
+	postID := analyzer.CreateBlogPost(item).PostID
+
+	// 저장된 분석 결과 확인
+	if postID != nil {
+		cached, err := s.analysisRepo.GetAnalysis(*postID)
+		if err != nil {
+			utils.DebugLog("분석 결과 조회 실패 (무시됨): %v\n", err)
+		} else if cached != nil {
+			utils.DebugLog("[%d] 저장된 분석 결과 사용: %s\n", index, postID.Key())
+			// 분석 상태가 없던 때 저장된 결과도 오류 없이 분석된 결과만 저장되었으므로 complete로 취급
+			if cached.AnalysisStatus == "" {
+				cached.AnalysisStatus = structure.AnalysisStatusComplete
+			}
+			return analyzer.CopyAnalysis(*cached, item)
+		}
+	}
 
-				utils.DebugLog("1-2. 첫 번째 스티커 URL 확인\n")
-				// 1-2. 첫 번째 스티커 URL 확인 (첫 번째 이미지 URL에서 발견되지 않은 경우)
-				if !foundSponsorDomain && crawlResult.FirstStickerURL != "" {
-					if foundDomain, matchedDomain := analyzer.CheckSponsorDomain(crawlResult.FirstStickerURL, constant.SPONSOR_DOMAINS); foundDomain {
-						foundSponsorDomain = true
-						foundURL = crawlResult.FirstStickerURL
-						sponsorType = structure.SponsorTypeSticker
-						domain = matchedDomain
-					}
-				}
+	blogPost := s.detectPost(index, item)
 
-				// 1-3. 협찬 도메인이 발견된 경우
-				if foundSponsorDomain {
-					// 협찬 도메인이 발견되었으므로 바로 협찬으로 판단
-					blogPost = analyzer.CreateSponsoredBlogPost(
-						item,
-						structure.Accuracy.Absolute,
-						foundURL,
-						structure.IndicatorTypeKeyword,
-						structure.PatternTypeNormal,
-						sponsorType,
-						domain,
-					)
-
-					// 중요: 도메인으로 협찬이 확인된 경우 에러 필드를 명시적으로 비웁니다
-					blogPost.Error = ""
-
-					// 결과 저장
-					mu.Lock()
-					results[index] = blogPost
-					mu.Unlock()
-					return
-				}
+	// 분석을 끝낸(complete) 결과만 저장
+	// OCR 대기열이 붐벼 건너뛴 결과(partial)는 Error가 비어 있어도 저장하지 않아 다음 요청에서 다시 분석
+	if postID != nil && blogPost.AnalysisStatus == structure.AnalysisStatusComplete {
+		if err := s.analysisRepo.SaveAnalysis(blogPost); err != nil {
+			utils.DebugLog("분석 결과 저장 실패 (무시됨): %v\n", err)
+		}
+	}
 
-				// 2. 도메인에서 협찬이 발견되지 않은 경우, 이미지/스티커 OCR 분석
-				// 2-1. 첫 번째 이미지 OCR 처리
-				if crawlResult.FirstImageURL != "" && !blogPost.IsSponsored && blogPost.Error == "" {
-					utils.DebugLog("2-1. 첫 번째 이미지 OCR 처리\n")
-					isSponsored, probability, indicators, errMsg := s.processOCR(crawlResult.FirstImageURL, structure.SponsorTypeImage)
-
-					if errMsg != "" {
-						// 오류 메시지 저장
-						analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, false, 0, nil, errMsg)
-					} else if isSponsored {
-						// 협찬 정보 업데이트
-						analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
-					}
-				}
+	return blogPost
+}
 
-				// 2-2. 첫 번째 스티커 OCR 처리 (첫 번째 이미지에서 스폰서가 발견되지 않은 경우)
-				if crawlResult.FirstStickerURL != "" && !blogPost.IsSponsored && blogPost.Error == "" {
-					utils.DebugLog("2-2. 첫 번째 스티커 OCR 처리\n")
-					isSponsored, probability, indicators, errMsg := s.processOCR(crawlResult.FirstStickerURL, structure.SponsorTypeSticker)
+// detectPost는 단일 포스트에서 협찬 관련 텍스트를 탐지합니다
+func (s *PostImpl) detectPost(index int, item structure.NaverSearchItem) structure.BlogPost {
+	utils.DebugLog("포스트 날짜: %v\n", item.PostDate)
+	// 2025년 이후 포스트인지 확인
+	is2025OrLater := utils.IsAfter2025(item.PostDate)
+
+	// 블로그 포스트 초기화 (analyzer 패키지 사용)
+	blogPost := analyzer.CreateBlogPost(item)
+
+	// 1. Description 텍스트 탐지 수행
+	isSponsored, probability, indicators := DetectSponsor(item.Description, structure.SponsorTypeDescription)
+
+	if isSponsored {
+		// 공통 함수 사용하여 스폰서 정보 업데이트
+		analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
+	} else {
+		// 2. Description에서 스폰서 탐지 실패시 본문 크롤링
+		crawlResult, err := s.crawlerService.CrawlBlogPost(item.Link, is2025OrLater)
+		if err != nil {
+			fmt.Printf("[%d] 크롤링 실패: %v\n", index, err)
+			// 크롤링 실패 시 에러 메시지 저장하고 결과 반환
+			blogPost.Error = fmt.Sprintf("크롤링 실패: %v", err)
+			analyzer.AddErrorCode(&blogPost, classifyError(err, structure.ErrorCodeCrawlFailed))
+			analyzer.SetAnalysisStatus(&blogPost, false)
+			return blogPost
+		}
 
-					// 첫 번째 스티커 OCR 결과가 너무 짧은 경우, 두 번째 스티커 시도
-					if errMsg == "OCR_TEXT_TOO_SHORT" && crawlResult.SecondStickerURL != "" && crawlResult.SecondStickerURL != crawlResult.FirstStickerURL {
-						utils.DebugLog("첫 번째 스티커 OCR 텍스트가 너무 짧아 두 번째 스티커 처리\n")
-						isSponsored, probability, indicators, errMsg = s.processOCR(crawlResult.SecondStickerURL, structure.SponsorTypeSticker)
-					}
+		// crawlResult가 nil인 경우 처리
+		if crawlResult == nil {
+			blogPost.Error = "크롤링 결과가 없습니다"
+			analyzer.AddErrorCode(&blogPost, structure.ErrorCodeCrawlFailed)
+			analyzer.SetAnalysisStatus(&blogPost, false)
+			return blogPost
+		}
 
-					if errMsg != "" && errMsg != "OCR_TEXT_TOO_SHORT" {
-						// 오류 메시지 저장
-						analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, false, 0, nil, errMsg)
-					} else if isSponsored {
-						// 협찬 정보 업데이트
-						analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
-					}
-				}
+		// 본문 분석 순서:
+		// 1. 첫 이미지/스티커 URL 도메인 확인
+		// 2. 도메인이 협찬이 아니면 첫 이미지/스티커 OCR 분석
+		// 3. 첫 문단 분석
+		// 4. 2025년 이전 포스트만: 마지막 문단/스티커/이미지 분석
+		// 5. 댓글 분석 (블로그 주인의 답글, 독자의 질문)
+
+		// 1. 첫 번째 이미지 URL과 스티커 URL 도메인 확인
+		foundSponsorDomain := false
+		var foundURL, domain string
+		sponsorType := structure.SponsorTypeImage
+
+		// 1-1. 첫 번째 이미지 URL 확인
+		utils.DebugLog("1-1. 첫 번째 이미지 URL 확인\n")
+		if foundDomain, matchedDomain := analyzer.CheckSponsorDomain(crawlResult.FirstImageURL, constant.SPONSOR_DOMAINS); foundDomain {
+			foundSponsorDomain = true
+			foundURL = crawlResult.FirstImageURL
+			sponsorType = structure.SponsorTypeImage
+			domain = matchedDomain
+		}
+
+		utils.DebugLog("1-2. 첫 번째 스티커 URL 확인\n")
+		// 1-2. 첫 번째 스티커 URL 확인 (첫 번째 이미지 URL에서 발견되지 않은 경우)
+		if !foundSponsorDomain && crawlResult.FirstStickerURL != "" {
+			if foundDomain, matchedDomain := analyzer.CheckSponsorDomain(crawlResult.FirstStickerURL, constant.SPONSOR_DOMAINS); foundDomain {
+				foundSponsorDomain = true
+				foundURL = crawlResult.FirstStickerURL
+				sponsorType = structure.SponsorTypeSticker
+				domain = matchedDomain
+			}
+		}
+
+		// 1-3. 협찬 도메인이 발견된 경우
+		if foundSponsorDomain {
+			// 협찬 도메인이 발견되었으므로 바로 협찬으로 판단
+			blogPost = analyzer.CreateSponsoredBlogPost(
+				item,
+				structure.Accuracy.Absolute,
+				foundURL,
+				structure.IndicatorTypeKeyword,
+				structure.PatternTypeNormal,
+				sponsorType,
+				domain,
+			)
+
+			// 중요: 도메인으로 협찬이 확인된 경우 에러 필드를 명시적으로 비웁니다
+			blogPost.Error = ""
+			analyzer.ApplyExtractorTrust(&blogPost, crawlResult)
+			analyzer.ApplyMetadata(&blogPost, crawlResult)
+			analyzer.SetAnalysisStatus(&blogPost, true)
+
+			// 결과 저장
+			return blogPost
+		}
+
+		// 2. 도메인에서 협찬이 발견되지 않은 경우, 이미지/스티커 OCR 분석
+		// 2-1. 첫 번째 이미지 OCR 처리
+		if crawlResult.FirstImageURL != "" && !blogPost.IsSponsored && blogPost.Error == "" {
+			utils.DebugLog("2-1. 첫 번째 이미지 OCR 처리\n")
+			isSponsored, probability, indicators, err := s.processOCR(crawlResult.FirstImageURL, structure.SponsorTypeImage, structure.OCRPriorityHigh)
+			applyOCRResult(&blogPost, isSponsored, probability, indicators, err)
+		}
 
-				// 3-1. 첫 번째 문단 분석 (이미지/스티커 OCR에서 스폰서가 발견되지 않은 경우)
-				if !blogPost.IsSponsored && blogPost.Error == "" {
-					utils.DebugLog("3-1. 첫 번째 문단 분석\n")
-					isSponsored, probability, indicators := DetectSponsor(crawlResult.FirstParagraph, structure.SponsorTypeParagraph)
+		// 2-2. 첫 번째 스티커 OCR 처리 (첫 번째 이미지에서 스폰서가 발견되지 않은 경우)
+		if crawlResult.FirstStickerURL != "" && !blogPost.IsSponsored && blogPost.Error == "" {
+			utils.DebugLog("2-2. 첫 번째 스티커 OCR 처리\n")
+			isSponsored, probability, indicators, err := s.processOCR(crawlResult.FirstStickerURL, structure.SponsorTypeSticker, structure.OCRPriorityHigh)
+
+			// 첫 번째 스티커 OCR 결과가 너무 짧은 경우, 두 번째 스티커 시도
+			if errors.Is(err, structure.ErrOCREmpty) && crawlResult.SecondStickerURL != "" && crawlResult.SecondStickerURL != crawlResult.FirstStickerURL {
+				utils.DebugLog("첫 번째 스티커 OCR 텍스트가 너무 짧아 두 번째 스티커 처리\n")
+				isSponsored, probability, indicators, err = s.processOCR(crawlResult.SecondStickerURL, structure.SponsorTypeSticker, structure.OCRPriorityHigh)
+			}
+			applyOCRResult(&blogPost, isSponsored, probability, indicators, err)
+		}
 
+		// 3-1. 첫 번째 문단 분석 (이미지/스티커 OCR에서 스폰서가 발견되지 않은 경우)
+		if !blogPost.IsSponsored && blogPost.Error == "" {
+			utils.DebugLog("3-1. 첫 번째 문단 분석\n")
+			isSponsored, probability, indicators := DetectSponsor(crawlResult.FirstParagraph, structure.SponsorTypeParagraph)
+
+			if isSponsored {
+				//협찬 정보 업데이트
+				analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
+			} else if !is2025OrLater { // 2025년 이전 포스트만 추가 분석 수행
+				// 3-2. 마지막 문단 분석 (첫 문단과 다른 경우만)
+				utils.DebugLog("3-2. 마지막 문단 분석 (2025년 이전 포스트만)\n")
+				if crawlResult.LastParagraph != "" && crawlResult.LastParagraph != crawlResult.FirstParagraph {
+					isSponsored, probability, indicators = DetectSponsor(crawlResult.LastParagraph, structure.SponsorTypeParagraph)
 					if isSponsored {
 						//협찬 정보 업데이트
 						analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
-					} else if !is2025OrLater { // 2025년 이전 포스트만 추가 분석 수행
-						// 3-2. 마지막 문단 분석 (첫 문단과 다른 경우만)
-						utils.DebugLog("3-2. 마지막 문단 분석 (2025년 이전 포스트만)\n")
-						if crawlResult.LastParagraph != "" && crawlResult.LastParagraph != crawlResult.FirstParagraph {
-							isSponsored, probability, indicators = DetectSponsor(crawlResult.LastParagraph, structure.SponsorTypeParagraph)
-							if isSponsored {
-								//협찬 정보 업데이트
-								analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
-							}
-						}
-
-						// 4. 마지막 스티커 이미지 OCR 처리 (협찬이 발견되지 않은 경우)
-						utils.DebugLog("4-1. 마지막 스티커 이미지 OCR 처리 (2025년 이전 포스트만)\n")
-						if !blogPost.IsSponsored && blogPost.Error == "" && crawlResult.LastStickerURL != "" && crawlResult.LastStickerURL != crawlResult.FirstStickerURL {
-							// 마지막 스티커 URL이 협찬 도메인인지 먼저 확인
-							if foundDomain, matchedDomain := analyzer.CheckSponsorDomain(crawlResult.LastStickerURL, constant.SPONSOR_DOMAINS); foundDomain {
-								// 협찬 도메인이 발견된 경우 바로 협찬으로 판단
-								analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, true, structure.Accuracy.Absolute, []structure.SponsorIndicator{
-									analyzer.CreateSponsorIndicator(
-										structure.IndicatorTypeKeyword,
-										structure.PatternTypeNormal,
-										crawlResult.LastStickerURL,
-										structure.Accuracy.Absolute,
-										structure.SponsorTypeSticker,
-										matchedDomain,
-									),
-								})
-							} else {
-								// 협찬 도메인이 아닌 경우 OCR 처리 진행
-								isSponsored, probability, indicators, errMsg := s.processOCR(crawlResult.LastStickerURL, structure.SponsorTypeSticker)
-
-								if errMsg != "" {
-									// 오류 메시지 저장
-									analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, false, 0, nil, errMsg)
-								} else if isSponsored {
-									// 협찬 정보 업데이트
-									analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
-								}
-							}
-						}
-
-						// 4-2. 마지막 이미지 OCR 처리 (협찬이 발견되지 않은 경우)
-						utils.DebugLog("4-2. 마지막 이미지 OCR 처리 (2025년 이전 포스트만)\n")
-						// 마지막 이미지 URL이 비어있지 않고 첫 번째 이미지 URL과 다르면 협찬 탐지 진행
-						if !blogPost.IsSponsored && crawlResult.LastImageURL != "" && crawlResult.LastImageURL != crawlResult.FirstImageURL {
-							// 마지막 이미지 URL이 협찬 도메인인지 먼저 확인
-							if foundDomain, matchedDomain := analyzer.CheckSponsorDomain(crawlResult.LastImageURL, constant.SPONSOR_DOMAINS); foundDomain {
-								// 협찬 도메인이 발견된 경우 바로 협찬으로 판단
-
-								analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, true, structure.Accuracy.Absolute, []structure.SponsorIndicator{
-									analyzer.CreateSponsorIndicator(
-										structure.IndicatorTypeKeyword,
-										structure.PatternTypeNormal,
-										crawlResult.LastImageURL,
-										structure.Accuracy.Absolute,
-										structure.SponsorTypeImage,
-										matchedDomain,
-									),
-								})
-							} else {
-								// 협찬 도메인이 아닌 경우 OCR 처리 진행
-								isSponsored, probability, indicators, errMsg := s.processOCR(crawlResult.LastImageURL, structure.SponsorTypeImage)
-
-								if errMsg != "" {
-									// 오류 메시지 저장
-									analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, false, 0, nil, errMsg)
-								} else if isSponsored {
-									// 협찬 정보 업데이트
-									analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
-								}
-							}
-						}
+					}
+				}
+
+				// 4. 마지막 스티커 이미지 OCR 처리 (협찬이 발견되지 않은 경우)
+				utils.DebugLog("4-1. 마지막 스티커 이미지 OCR 처리 (2025년 이전 포스트만)\n")
+				// 본문 끝 부분의 스티커를 우선 사용 (블록 정보가 없으면 마지막 스티커 사용)
+				lastStickerURL := crawlResult.LastStickerURL
+				if sticker := crawlResult.FindNearEnd(structure.BlockTypeSticker, constant.NEAR_END_BLOCKS); sticker != nil {
+					lastStickerURL = sticker.ImageURL
+				}
+				if !blogPost.IsSponsored && blogPost.Error == "" && lastStickerURL != "" && lastStickerURL != crawlResult.FirstStickerURL {
+					// 마지막 스티커 URL이 협찬 도메인인지 먼저 확인
+					if foundDomain, matchedDomain := analyzer.CheckSponsorDomain(lastStickerURL, constant.SPONSOR_DOMAINS); foundDomain {
+						// 협찬 도메인이 발견된 경우 바로 협찬으로 판단
+						analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, true, structure.Accuracy.Absolute, []structure.SponsorIndicator{
+							analyzer.CreateSponsorIndicator(
+								structure.IndicatorTypeKeyword,
+								structure.PatternTypeNormal,
+								lastStickerURL,
+								structure.Accuracy.Absolute,
+								structure.SponsorTypeSticker,
+								matchedDomain,
+							),
+						})
+					} else {
+						// 협찬 도메인이 아닌 경우 OCR 처리 진행
+						isSponsored, probability, indicators, err := s.processOCR(lastStickerURL, structure.SponsorTypeSticker, structure.OCRPriorityLow)
+						applyOCRResult(&blogPost, isSponsored, probability, indicators, err)
+					}
+				}
+
+				// 4-2. 마지막 이미지 OCR 처리 (협찬이 발견되지 않은 경우)
+				utils.DebugLog("4-2. 마지막 이미지 OCR 처리 (2025년 이전 포스트만)\n")
+				// 마지막 이미지 URL이 비어있지 않고 첫 번째 이미지 URL과 다르면 협찬 탐지 진행
+				if !blogPost.IsSponsored && crawlResult.LastImageURL != "" && crawlResult.LastImageURL != crawlResult.FirstImageURL {
+					// 마지막 이미지 URL이 협찬 도메인인지 먼저 확인
+					if foundDomain, matchedDomain := analyzer.CheckSponsorDomain(crawlResult.LastImageURL, constant.SPONSOR_DOMAINS); foundDomain {
+						// 협찬 도메인이 발견된 경우 바로 협찬으로 판단
+
+						analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, true, structure.Accuracy.Absolute, []structure.SponsorIndicator{
+							analyzer.CreateSponsorIndicator(
+								structure.IndicatorTypeKeyword,
+								structure.PatternTypeNormal,
+								crawlResult.LastImageURL,
+								structure.Accuracy.Absolute,
+								structure.SponsorTypeImage,
+								matchedDomain,
+							),
+						})
 					} else {
-						utils.DebugLog("2025년 이후 포스트이므로 마지막 문단/스티커/이미지 분석 건너뜀\n")
+						// 협찬 도메인이 아닌 경우 OCR 처리 진행
+						isSponsored, probability, indicators, err := s.processOCR(crawlResult.LastImageURL, structure.SponsorTypeImage, structure.OCRPriorityLow)
+						applyOCRResult(&blogPost, isSponsored, probability, indicators, err)
 					}
 				}
+			} else {
+				utils.DebugLog("2025년 이후 포스트이므로 마지막 문단/스티커/이미지 분석 건너뜀\n")
 			}
+		}
 
-			// 결과 저장
-			mu.Lock()
-			results[index] = blogPost
-			mu.Unlock()
-		}(i, post)
-	}
+		// 5. 댓글 분석 (본문에서 협찬이 발견되지 않은 경우)
+		if !blogPost.IsSponsored && blogPost.Error == "" && len(crawlResult.Comments) > 0 {
+			utils.DebugLog("5. 댓글 분석 (%d개)\n", len(crawlResult.Comments))
+			isSponsored, probability, indicators := DetectCommentSponsor(crawlResult.Comments)
+			if isSponsored {
+				analyzer.UpdateBlogPostWithSponsorInfo(&blogPost, isSponsored, probability, indicators)
+			}
+		}
 
-	// 모든 고루틴이 완료될 때까지 대기
-	wg.Wait()
+		// 범용 추출기로 얻은 본문은 신뢰도만큼 협찬 확률을 낮춤
+		analyzer.ApplyExtractorTrust(&blogPost, crawlResult)
+		analyzer.ApplyMetadata(&blogPost, crawlResult)
+	}
 
-	return results, nil
+	analyzer.SetAnalysisStatus(&blogPost, true)
+	return blogPost
 }
 
 // DetectSponsor는 텍스트에서 협찬 여부를 감지합니다
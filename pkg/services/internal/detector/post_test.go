@@ -0,0 +1,69 @@
+package detector
+
+import (
+	"testing"
+
+	repository "github.com/sh5080/ndns-go/pkg/repositories"
+	structure "github.com/sh5080/ndns-go/pkg/types/structures"
+)
+
+// fakeCrawler는 고정된 크롤링 결과를 반환합니다
+type fakeCrawler struct {
+	result *structure.CrawlResult
+}
+
+func (c *fakeCrawler) CrawlBlogPost(url string, is2025OrLater bool) (*structure.CrawlResult, error) {
+	return c.result, nil
+}
+
+// fakeOCR은 고정된 OCR 결과를 반환합니다
+type fakeOCR struct {
+	text string
+	err  error
+}
+
+func (o *fakeOCR) ExtractTextFromImage(imageURL string, priority structure.OCRPriority) (string, error) {
+	return o.text, o.err
+}
+
+func TestAnalyzePostSkipsCachingBusyResult(t *testing.T) {
+	item := structure.NaverSearchItem{
+		Title:       "맛집 후기",
+		Link:        "https://blog.naver.com/tester/223000000001",
+		Description: "주말에 다녀온 맛집",
+		PostDate:    "20250301",
+	}
+	crawlResult := &structure.CrawlResult{
+		URL:            item.Link,
+		FirstParagraph: "주말에 다녀온 맛집입니다",
+		FirstImageURL:  "https://postfiles.pstatic.net/image.jpg",
+	}
+
+	ocrService := &fakeOCR{err: structure.ErrOCRBusy}
+	analysisRepo := repository.NewAnalysisRepository(10)
+	service := &PostImpl{
+		ocrService:     ocrService,
+		crawlerService: &fakeCrawler{result: crawlResult},
+		analysisRepo:   analysisRepo,
+	}
+
+	// OCR 대기열이 붐비면 partial로 반환하고 저장하지 않음
+	busy := service.analyzePost(0, item)
+	if busy.AnalysisStatus != structure.AnalysisStatusPartial || busy.Error != "" {
+		t.Fatalf("AnalysisStatus = %q, Error = %q, want partial without error", busy.AnalysisStatus, busy.Error)
+	}
+	if cached, _ := analysisRepo.GetAnalysis(*busy.PostID); cached != nil {
+		t.Fatalf("OCR 대기열 초과로 건너뛴 결과가 저장되었습니다: %+v", cached)
+	}
+
+	// OCR이 처리되면 complete로 저장
+	ocrService.err = nil
+	ocrService.text = "오늘 방문한 가게의 메뉴판과 가격표입니다"
+	complete := service.analyzePost(0, item)
+	if complete.AnalysisStatus != structure.AnalysisStatusComplete {
+		t.Fatalf("AnalysisStatus = %q, want complete", complete.AnalysisStatus)
+	}
+	if cached, _ := analysisRepo.GetAnalysis(*complete.PostID); cached == nil {
+		t.Errorf("분석을 끝낸 결과가 저장되지 않았습니다")
+	}
+}
@@ -0,0 +1,44 @@
+package ocr
+
+import (
+	"fmt"
+
+	"github.com/sh5080/ndns-go/pkg/configs"
+	_interface "github.com/sh5080/ndns-go/pkg/interfaces"
+)
+
+// EngineKind는 OCR_ENGINE으로 선택하는 OCR 엔진 종류입니다
+type EngineKind string
+
+const (
+	EngineTesseract EngineKind = "tesseract" // 로컬 Tesseract CLI
+	EngineRemote    EngineKind = "remote"    // 원격 HTTP OCR 서비스
+	EngineFake      EngineKind = "fake"      // 고정 결과를 반환하는 엔진 (테스트, 부하 테스트용)
+)
+
+// NewEngine은 설정에 따라 OCR 엔진을 생성합니다
+// 설정이 잘못되었으면 에러를 로깅하고 로컬 Tesseract 엔진을 사용합니다
+func NewEngine(config *configs.EnvConfig) _interface.OCREngine {
+	engine, err := newEngine(config)
+	if err != nil {
+		fmt.Printf("OCR 엔진 초기화 실패 (tesseract 사용): %v\n", err)
+		engine = NewTesseractEngine(config.OCR.TesseractPath, config.OCR.Languages, config.OCR.TessdataDir)
+	}
+
+	fmt.Printf("OCR 엔진: %s\n", engine.Name())
+	return engine
+}
+
+// newEngine은 OCR_ENGINE에 해당하는 엔진을 생성합니다
+func newEngine(config *configs.EnvConfig) (_interface.OCREngine, error) {
+	switch EngineKind(config.OCR.Engine) {
+	case EngineTesseract, "":
+		return NewTesseractEngine(config.OCR.TesseractPath, config.OCR.Languages, config.OCR.TessdataDir), nil
+	case EngineRemote:
+		return NewRemoteEngine(config.OCR.RemoteURL, config.OCR.Languages)
+	case EngineFake:
+		return NewFakeEngine(""), nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 OCR 엔진입니다: %q", config.OCR.Engine)
+	}
+}
@@ -0,0 +1,208 @@
+package ocr
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sh5080/ndns-go/pkg/configs"
+	"github.com/sh5080/ndns-go/pkg/transport"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	"github.com/sh5080/ndns-go/pkg/utils"
+)
+
+// writeImage는 테스트용 이미지 파일을 만듭니다
+func writeImage(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "image.png")
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("이미지 파일 생성 실패: %v", err)
+	}
+	return path
+}
+
+// useDirectTransport는 테스트 동안 내부 서비스용 RoundTripper를 rt로 교체합니다
+func useDirectTransport(t *testing.T, rt http.RoundTripper) {
+	t.Helper()
+	transport.SetDirectTransport(rt)
+	t.Cleanup(func() { transport.SetDirectTransport(nil) })
+}
+
+func TestRemoteEngineRecognize(t *testing.T) {
+	useDirectTransport(t, http.DefaultTransport)
+	imagePath := writeImage(t, "\x89PNG\r\n\x1a\nimage")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case r.Method != http.MethodPost:
+			http.Error(w, "method", http.StatusMethodNotAllowed)
+		case r.Header.Get(constants.OCR_REMOTE_LANGUAGES_HEADER) != "kor+eng":
+			http.Error(w, "languages", http.StatusBadRequest)
+		case r.Header.Get("Content-Type") != "image/png" || string(body) != "\x89PNG\r\n\x1a\nimage":
+			http.Error(w, "image", http.StatusBadRequest)
+		default:
+			w.Write([]byte(`{"text": "업체로부터 제품을 제공받았습니다"}`))
+		}
+	}))
+	defer server.Close()
+
+	engine, err := NewRemoteEngine(server.URL, "kor+eng")
+	if err != nil {
+		t.Fatalf("NewRemoteEngine 실패: %v", err)
+	}
+	text, err := engine.Recognize(context.Background(), imagePath)
+	if err != nil || text != "업체로부터 제품을 제공받았습니다" {
+		t.Errorf("Recognize = %q, %v", text, err)
+	}
+}
+
+func TestRemoteEngineErrors(t *testing.T) {
+	useDirectTransport(t, http.DefaultTransport)
+	imagePath := writeImage(t, "image")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		http.Error(w, "model not loaded", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	engine, _ := NewRemoteEngine(server.URL, "kor")
+	if _, err := engine.Recognize(context.Background(), imagePath); err == nil {
+		t.Errorf("503 응답에서 에러가 반환되지 않았습니다")
+	}
+
+	// 시간 초과는 컨텍스트 에러로 반환 (OCR 처리 시간 초과로 분류)
+	slow, _ := NewRemoteEngine(server.URL+"/slow", "kor")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := slow.Recognize(ctx, imagePath); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Recognize 시간 초과 에러 = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestRemoteEngineFollowsRouteURL(t *testing.T) {
+	useDirectTransport(t, http.DefaultTransport)
+	t.Cleanup(func() { transport.SetRouteURL(constants.OCR_REMOTE_ROUTE_NAME, "") })
+	imagePath := writeImage(t, "\x89PNG\r\n\x1a\nimage")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/ocr" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"text": "소정의 원고료를 받았습니다"}`))
+	}))
+	defer server.Close()
+
+	// 설정한 주소로 요청할 수 없어도 ocr 경로 주소(ngrok 터널 등)가 바뀌면 새 주소로 요청 (경로는 유지)
+	engine, _ := NewRemoteEngine("http://127.0.0.1:1/v1/ocr", "kor")
+	if _, err := engine.Recognize(context.Background(), imagePath); err == nil {
+		t.Fatal("설정한 주소로 요청이 성공했습니다")
+	}
+	transport.SetRouteURL(constants.OCR_REMOTE_ROUTE_NAME, server.URL)
+	if text, err := engine.Recognize(context.Background(), imagePath); err != nil || text != "소정의 원고료를 받았습니다" {
+		t.Errorf("바뀐 주소 Recognize = %q, %v", text, err)
+	}
+}
+
+func TestRemoteEngineReplaysCassette(t *testing.T) {
+	imagePath := writeImage(t, "image")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"text": "소정의 원고료를 받았습니다"}`))
+	}))
+	dir := t.TempDir()
+
+	// 기록 모드에서 원격 OCR 응답을 기록
+	recorder, _ := transport.NewCassette(transport.CassetteModeRecord, dir, http.DefaultTransport)
+	useDirectTransport(t, recorder)
+	engine, _ := NewRemoteEngine(server.URL, "kor")
+	if text, err := engine.Recognize(context.Background(), imagePath); err != nil || text != "소정의 원고료를 받았습니다" {
+		t.Fatalf("기록 모드 Recognize = %q, %v", text, err)
+	}
+	server.Close()
+
+	// 재생 모드에서는 서비스 없이 기록된 응답을 사용
+	player, _ := transport.NewCassette(transport.CassetteModeReplay, dir, nil)
+	useDirectTransport(t, player)
+	engine, _ = NewRemoteEngine(server.URL, "kor")
+	if text, err := engine.Recognize(context.Background(), imagePath); err != nil || text != "소정의 원고료를 받았습니다" {
+		t.Errorf("재생 모드 Recognize = %q, %v", text, err)
+	}
+}
+
+func TestFakeEngineIsDeterministic(t *testing.T) {
+	sponsored := writeImage(t, "sponsored")
+	other := writeImage(t, "other")
+	failing := writeImage(t, "failing")
+
+	engine := NewFakeEngine("기본 텍스트")
+	hash, _ := utils.HashImageFile(sponsored)
+	engine.SetText(hash, "협찬")
+	failingHash, _ := utils.HashImageFile(failing)
+	engine.SetError(failingHash, errors.New("broken"))
+
+	for i := 0; i < 2; i++ {
+		if text, _ := engine.Recognize(context.Background(), sponsored); text != "협찬" {
+			t.Errorf("Recognize(sponsored) = %q, want 협찬", text)
+		}
+		if text, _ := engine.Recognize(context.Background(), other); text != "기본 텍스트" {
+			t.Errorf("Recognize(other) = %q, want 기본 텍스트", text)
+		}
+	}
+	if _, err := engine.Recognize(context.Background(), failing); err == nil {
+		t.Errorf("Recognize(failing) 에러가 반환되지 않았습니다")
+	}
+	if engine.Calls() != 5 {
+		t.Errorf("Calls = %d, want 5", engine.Calls())
+	}
+}
+
+func TestTesseractEngineArgs(t *testing.T) {
+	engine := NewTesseractEngine(filepath.Join(t.TempDir(), "missing"), "kor+eng", "/opt/tessdata")
+	if engine.path != "tesseract" {
+		t.Errorf("없는 실행 파일 경로 = %q, want PATH의 tesseract", engine.path)
+	}
+
+	args := engine.args("image.png")
+	for _, want := range [][]string{{"-l", "kor+eng"}, {"--tessdata-dir", "/opt/tessdata"}, {"--psm", constants.TESSERACT_PSM}} {
+		index := slices.Index(args, want[0])
+		if index < 0 || index+1 >= len(args) || args[index+1] != want[1] {
+			t.Errorf("args = %v, want %s %s", args, want[0], want[1])
+		}
+	}
+	if slices.Contains(NewTesseractEngine("", "", "").args("image.png"), "--tessdata-dir") {
+		t.Errorf("tessdata 디렉토리를 설정하지 않았는데 --tessdata-dir가 포함되었습니다")
+	}
+}
+
+func TestNewEngine(t *testing.T) {
+	useDirectTransport(t, http.DefaultTransport)
+	tests := []struct {
+		engine    string
+		remoteURL string
+		want      string
+	}{
+		{"", "", "tesseract"},
+		{"remote", "http://ocr.internal/recognize", "remote"},
+		{"fake", "", "fake"},
+		{"remote", "", "tesseract"}, // 주소가 없으면 tesseract 사용
+		{"paddle", "", "tesseract"}, // 지원하지 않는 엔진
+	}
+
+	for _, tt := range tests {
+		config := &configs.EnvConfig{}
+		config.OCR.Engine = tt.engine
+		config.OCR.RemoteURL = tt.remoteURL
+		if got := NewEngine(config).Name(); got != tt.want {
+			t.Errorf("NewEngine(%q).Name() = %q, want %q", tt.engine, got, tt.want)
+		}
+	}
+}
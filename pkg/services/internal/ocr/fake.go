@@ -0,0 +1,78 @@
+package ocr
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sh5080/ndns-go/pkg/utils"
+)
+
+// FakeEngine은 이미지 내용(SHA-256)별로 정해 둔 텍스트를 반환하는 OCR 엔진입니다
+// 엔진에 전달된 파일의 내용으로 찾으므로, 잘라낸 이미지는 잘라낸 파일의 해시로 정해야 합니다
+// Tesseract 없이 같은 입력에 항상 같은 결과를 내므로 테스트와 부하 테스트에 사용합니다
+type FakeEngine struct {
+	texts       map[string]string // 이미지 내용 해시 → 인식 결과
+	defaultText string            // 정해 두지 않은 이미지의 인식 결과
+	errs        map[string]error  // 이미지 내용 해시 → 인식 에러
+	calls       int
+	lock        sync.Mutex
+}
+
+// NewFakeEngine은 모든 이미지에 defaultText를 반환하는 가짜 엔진을 생성합니다
+func NewFakeEngine(defaultText string) *FakeEngine {
+	return &FakeEngine{
+		texts:       make(map[string]string),
+		defaultText: defaultText,
+		errs:        make(map[string]error),
+	}
+}
+
+// SetText는 내용 해시가 contentHash인 이미지의 인식 결과를 정합니다
+func (e *FakeEngine) SetText(contentHash, text string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.texts[contentHash] = text
+}
+
+// SetError는 내용 해시가 contentHash인 이미지를 인식할 때 반환할 에러를 정합니다
+func (e *FakeEngine) SetError(contentHash string, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.errs[contentHash] = err
+}
+
+// Calls는 Recognize가 호출된 횟수를 반환합니다
+func (e *FakeEngine) Calls() int {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.calls
+}
+
+// Name은 엔진 이름을 반환합니다
+func (e *FakeEngine) Name() string {
+	return string(EngineFake)
+}
+
+// Recognize는 이미지 내용 해시에 정해 둔 결과를 반환합니다
+func (e *FakeEngine) Recognize(ctx context.Context, imagePath string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	contentHash, err := utils.HashImageFile(imagePath)
+	if err != nil {
+		return "", err
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.calls++
+
+	if err, exists := e.errs[contentHash]; exists {
+		return "", err
+	}
+	if text, exists := e.texts[contentHash]; exists {
+		return text, nil
+	}
+	return e.defaultText, nil
+}
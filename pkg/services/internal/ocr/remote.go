@@ -0,0 +1,109 @@
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/sh5080/ndns-go/pkg/transport"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+)
+
+// RemoteEngine은 원격 HTTP OCR 서비스에 이미지를 보내 텍스트를 인식하는 OCR 엔진입니다 (GPU 없는 워커용)
+// 서비스는 이미지 본문을 POST로 받아 {"text": "..."} 형식으로 응답합니다
+// 서비스 주소는 ocr 워커 경로로 등록하므로, ngrok 터널 주소가 바뀌면 재시작 없이 새 주소로 요청합니다
+type RemoteEngine struct {
+	route     transport.EgressRoute // 설정한 서비스 주소 (SetRouteURL로 바뀐 주소 우선)
+	languages string
+	client    *http.Client
+}
+
+// remoteResponse는 원격 OCR 서비스의 응답입니다
+type remoteResponse struct {
+	Text string `json:"text"`
+}
+
+// NewRemoteEngine은 serviceURL의 원격 OCR 서비스를 사용하는 엔진을 생성합니다
+func NewRemoteEngine(serviceURL, languages string) (*RemoteEngine, error) {
+	if serviceURL == "" {
+		return nil, fmt.Errorf("원격 OCR 서비스 주소(OCR_REMOTE_URL)가 비어 있습니다")
+	}
+	if languages == "" {
+		languages = "kor"
+	}
+
+	// OCR 서비스는 내부 서비스이므로 호스트 요청 제한과 외부 요청 경로(워커 프록시 등)를 거치지 않음 (기록/재생은 적용)
+	// 처리 시간 제한은 요청 컨텍스트로 적용
+	return &RemoteEngine{
+		route:     transport.WorkerRoute(constants.OCR_REMOTE_ROUTE_NAME, serviceURL),
+		languages: languages,
+		client:    transport.NewDirectClient(0),
+	}, nil
+}
+
+// Name은 엔진 이름을 반환합니다
+func (e *RemoteEngine) Name() string {
+	return string(EngineRemote)
+}
+
+// Recognize는 원격 OCR 서비스로 이미지 파일의 텍스트를 인식합니다
+func (e *RemoteEngine) Recognize(ctx context.Context, imagePath string) (string, error) {
+	image, err := os.ReadFile(imagePath)
+	if err != nil {
+		return "", fmt.Errorf("이미지 파일 읽기 실패: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint(), bytes.NewReader(image))
+	if err != nil {
+		return "", fmt.Errorf("원격 OCR 요청 생성 실패: %v", err)
+	}
+	req.Header.Set("Content-Type", http.DetectContentType(image))
+	req.Header.Set(constants.OCR_REMOTE_LANGUAGES_HEADER, e.languages)
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		// 시간 초과는 호출한 쪽에서 구분할 수 있도록 그대로 반환
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", fmt.Errorf("원격 OCR 요청 실패: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", fmt.Errorf("원격 OCR HTTP 오류 (%d): %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	var result remoteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("원격 OCR 응답 파싱 실패: %v", err)
+	}
+	return result.Text, nil
+}
+
+// endpoint는 요청을 보낼 OCR 서비스 주소를 반환합니다
+// 터널 주소는 스킴과 호스트만 가지므로, 경로 주소가 바뀌었으면 설정한 주소의 경로와 쿼리는 유지합니다
+func (e *RemoteEngine) endpoint() string {
+	current := e.route.CurrentURL()
+	if current == e.route.URL {
+		return current
+	}
+
+	configured, err := url.Parse(e.route.URL)
+	if err != nil {
+		return current
+	}
+	replaced, err := url.Parse(current)
+	if err != nil || replaced.Host == "" {
+		return e.route.URL
+	}
+	configured.Scheme = replaced.Scheme
+	configured.Host = replaced.Host
+	return configured.String()
+}
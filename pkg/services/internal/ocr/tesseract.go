@@ -0,0 +1,77 @@
+package ocr
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+)
+
+// TesseractEngine은 로컬 Tesseract CLI를 실행하는 OCR 엔진입니다
+type TesseractEngine struct {
+	path        string // Tesseract 실행 파일
+	languages   string // kor, kor+eng 등
+	tessdataDir string // 빈 값이면 TESSDATA_PREFIX 또는 Tesseract 기본 경로
+}
+
+// NewTesseractEngine은 path의 Tesseract를 실행하는 OCR 엔진을 생성합니다
+// path에 파일이 없으면 PATH에서 찾은 tesseract를 사용합니다 (로컬 개발 환경)
+func NewTesseractEngine(path, languages, tessdataDir string) *TesseractEngine {
+	if languages == "" {
+		languages = "kor"
+	}
+	return &TesseractEngine{
+		path:        resolveTesseractPath(path),
+		languages:   languages,
+		tessdataDir: tessdataDir,
+	}
+}
+
+// resolveTesseractPath는 실행할 Tesseract 경로를 찾습니다
+func resolveTesseractPath(path string) string {
+	if path != "" {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+		fmt.Printf("Tesseract 실행 파일 없음 (PATH에서 찾음): %s\n", path)
+	}
+	return "tesseract"
+}
+
+// Name은 엔진 이름을 반환합니다
+func (e *TesseractEngine) Name() string {
+	return string(EngineTesseract)
+}
+
+// Recognize는 Tesseract로 이미지 파일의 텍스트를 인식합니다
+// 실행에 실패하면 에러를, 인식한 텍스트가 없으면 빈 문자열을 반환합니다
+func (e *TesseractEngine) Recognize(ctx context.Context, imagePath string) (string, error) {
+	cmd := exec.CommandContext(ctx, e.path, e.args(imagePath)...)
+	output, err := cmd.CombinedOutput()
+
+	// 컨텍스트 취소 확인 (시간 초과로 프로세스가 종료된 경우)
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+	if err != nil {
+		return "", fmt.Errorf("Tesseract 실행 오류: %v (%s)", err, strings.TrimSpace(string(output)))
+	}
+
+	textResult := strings.TrimSpace(string(output))
+	if strings.Contains(textResult, "Estimating") {
+		return "", nil
+	}
+	return textResult, nil
+}
+
+// args는 Tesseract 실행 인자를 만듭니다
+func (e *TesseractEngine) args(imagePath string) []string {
+	args := []string{imagePath, "stdout", "-l", e.languages, "--psm", constants.TESSERACT_PSM, "--oem", constants.TESSERACT_OEM}
+	if e.tessdataDir != "" {
+		args = append(args, "--tessdata-dir", e.tessdataDir)
+	}
+	return append(args, "-c", "preserve_interword_spaces=1")
+}
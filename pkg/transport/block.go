@@ -0,0 +1,169 @@
+package transport
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	"github.com/sh5080/ndns-go/pkg/utils"
+)
+
+// BlockReason은 호스트가 요청을 차단했다고 판단한 근거입니다
+type BlockReason string
+
+const (
+	BlockReasonStatus    BlockReason = "status"     // 차단 상태 코드 (429, 반복된 403)
+	BlockReasonCaptcha   BlockReason = "captcha"    // 캡차 페이지
+	BlockReasonBlockPage BlockReason = "block_page" // 접근 제한 안내 페이지
+	BlockReasonEmptyPage BlockReason = "empty_page" // 본문이 비어 있는 페이지
+)
+
+// BlockedError는 호스트가 요청을 차단했거나, 차단 후 대기 시간 중이라 요청을 보내지 않았을 때 반환됩니다
+type BlockedError struct {
+	Host   string
+	Reason BlockReason
+	Detail string
+	Until  time.Time // 이 시각까지 해당 호스트로 요청을 보내지 않음
+}
+
+// Error는 차단 정보를 문자열로 반환합니다
+func (e *BlockedError) Error() string {
+	message := fmt.Sprintf("%s 요청이 차단되었습니다 (%s", e.Host, e.Reason)
+	if e.Detail != "" {
+		message += ": " + e.Detail
+	}
+	return message + fmt.Sprintf(", %s까지 대기)", e.Until.Format(time.RFC3339))
+}
+
+// BlockStatus는 대기 중인 호스트의 차단 상태입니다
+type BlockStatus struct {
+	Host    string
+	Reason  BlockReason
+	Detail  string
+	Since   time.Time
+	Until   time.Time
+	Strikes int // 연속 차단 횟수 (대기 시간은 차단될 때마다 2배씩 증가)
+}
+
+// forbiddenCount는 호스트의 최근 403 응답 횟수입니다
+type forbiddenCount struct {
+	count int
+	since time.Time // 횟수를 세기 시작한 시각
+}
+
+// blockRegistry는 프로세스 전체에서 공유하는 호스트별 차단 상태입니다
+var blockRegistry = struct {
+	hosts     map[string]*BlockStatus
+	forbidden map[string]*forbiddenCount
+	lock      sync.Mutex
+}{hosts: make(map[string]*BlockStatus), forbidden: make(map[string]*forbiddenCount)}
+
+// ReportBlocked는 호스트 차단을 기록하고 대기 시간을 시작합니다
+// 이미 대기 중인 호스트이면 기존 대기 시간을 유지합니다
+func ReportBlocked(host string, reason BlockReason, detail string) *BlockedError {
+	host = strings.ToLower(host)
+	now := time.Now()
+
+	blockRegistry.lock.Lock()
+	defer blockRegistry.lock.Unlock()
+
+	status, exists := blockRegistry.hosts[host]
+	if !exists || !now.Before(status.Until) {
+		strikes := 1
+		// 대기 시간이 끝난 직후 다시 차단되면 대기 시간을 늘림
+		if exists && now.Sub(status.Until) < constants.BLOCK_MAX_COOLDOWN {
+			strikes = status.Strikes + 1
+		}
+
+		cooldown := constants.BLOCK_COOLDOWN << (strikes - 1)
+		if cooldown <= 0 || cooldown > constants.BLOCK_MAX_COOLDOWN {
+			cooldown = constants.BLOCK_MAX_COOLDOWN
+		}
+
+		status = &BlockStatus{
+			Host:    host,
+			Reason:  reason,
+			Detail:  detail,
+			Since:   now,
+			Until:   now.Add(cooldown),
+			Strikes: strikes,
+		}
+		blockRegistry.hosts[host] = status
+
+		fmt.Printf("호스트 차단 감지: %s (%s %s), %v 동안 요청 중지\n", host, reason, detail, cooldown)
+		utils.RecordBlocked(host, string(reason))
+	}
+	return &BlockedError{Host: host, Reason: status.Reason, Detail: status.Detail, Until: status.Until}
+}
+
+// ReportForbidden은 호스트의 403 응답을 기록합니다
+// 403은 비공개, 삭제된 포스트처럼 주소 하나에 대한 응답인 경우가 많으므로
+// BLOCK_FORBIDDEN_WINDOW 안에 BLOCK_FORBIDDEN_THRESHOLD번 반복될 때만 차단으로 기록하고 BlockedError를 반환합니다 (그 전에는 nil)
+func ReportForbidden(host string) *BlockedError {
+	host = strings.ToLower(host)
+	now := time.Now()
+
+	blockRegistry.lock.Lock()
+	counter, exists := blockRegistry.forbidden[host]
+	if !exists || now.Sub(counter.since) > constants.BLOCK_FORBIDDEN_WINDOW {
+		counter = &forbiddenCount{since: now}
+		blockRegistry.forbidden[host] = counter
+	}
+	counter.count++
+	count := counter.count
+	if count >= constants.BLOCK_FORBIDDEN_THRESHOLD {
+		delete(blockRegistry.forbidden, host)
+	}
+	blockRegistry.lock.Unlock()
+
+	if count < constants.BLOCK_FORBIDDEN_THRESHOLD {
+		return nil
+	}
+	return ReportBlocked(host, BlockReasonStatus, fmt.Sprintf("HTTP 403 %d회", count))
+}
+
+// ResetBlocked는 모든 호스트의 차단 상태와 403 응답 횟수를 지웁니다 (테스트 등)
+func ResetBlocked() {
+	blockRegistry.lock.Lock()
+	defer blockRegistry.lock.Unlock()
+
+	blockRegistry.hosts = make(map[string]*BlockStatus)
+	blockRegistry.forbidden = make(map[string]*forbiddenCount)
+}
+
+// CheckBlocked는 호스트가 차단 대기 중이면 BlockedError를, 아니면 nil을 반환합니다
+func CheckBlocked(host string) *BlockedError {
+	host = strings.ToLower(host)
+
+	blockRegistry.lock.Lock()
+	defer blockRegistry.lock.Unlock()
+
+	status, exists := blockRegistry.hosts[host]
+	if !exists || !time.Now().Before(status.Until) {
+		return nil
+	}
+	return &BlockedError{Host: host, Reason: status.Reason, Detail: status.Detail, Until: status.Until}
+}
+
+// BlockedHosts는 현재 차단 대기 중인 호스트 목록을 반환합니다
+func BlockedHosts() []BlockStatus {
+	now := time.Now()
+
+	blockRegistry.lock.Lock()
+	defer blockRegistry.lock.Unlock()
+
+	var hosts []BlockStatus
+	for _, status := range blockRegistry.hosts {
+		if now.Before(status.Until) {
+			hosts = append(hosts, *status)
+		}
+	}
+
+	sort.Slice(hosts, func(i, j int) bool {
+		return hosts[i].Host < hosts[j].Host
+	})
+	return hosts
+}
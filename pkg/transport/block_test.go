@@ -0,0 +1,122 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+)
+
+func TestReportBlockedStartsCooldown(t *testing.T) {
+	ResetBlocked()
+	t.Cleanup(ResetBlocked)
+
+	if err := CheckBlocked("blog.naver.com"); err != nil {
+		t.Fatalf("차단 전 CheckBlocked = %v, want nil", err)
+	}
+
+	first := ReportBlocked("Blog.Naver.com", BlockReasonCaptcha, "ncaptcha")
+	if first.Host != "blog.naver.com" || first.Reason != BlockReasonCaptcha {
+		t.Errorf("ReportBlocked() = %+v", first)
+	}
+	if cooldown := time.Until(first.Until); cooldown <= constants.BLOCK_COOLDOWN-time.Second || cooldown > constants.BLOCK_COOLDOWN {
+		t.Errorf("대기 시간 = %v, want %v", cooldown, constants.BLOCK_COOLDOWN)
+	}
+
+	// 대기 중에 다시 보고되면 기존 대기 상태를 유지
+	second := ReportBlocked("blog.naver.com", BlockReasonStatus, "HTTP 429")
+	if second.Reason != BlockReasonCaptcha || !second.Until.Equal(first.Until) {
+		t.Errorf("대기 중 ReportBlocked() = %+v, want 기존 상태 %+v", second, first)
+	}
+
+	if err := CheckBlocked("blog.naver.com"); err == nil || err.Reason != BlockReasonCaptcha {
+		t.Errorf("CheckBlocked() = %v, want captcha 차단", err)
+	}
+	if err := CheckBlocked("m.blog.naver.com"); err != nil {
+		t.Errorf("다른 호스트 CheckBlocked() = %v, want nil", err)
+	}
+
+	hosts := BlockedHosts()
+	if len(hosts) != 1 || hosts[0].Host != "blog.naver.com" || hosts[0].Strikes != 1 {
+		t.Errorf("BlockedHosts() = %+v", hosts)
+	}
+}
+
+func TestReportBlockedEscalatesCooldown(t *testing.T) {
+	ResetBlocked()
+	t.Cleanup(ResetBlocked)
+
+	// 직전 대기 시간이 막 끝난 상태
+	blockRegistry.hosts["blog.naver.com"] = &BlockStatus{
+		Host:    "blog.naver.com",
+		Reason:  BlockReasonStatus,
+		Until:   time.Now().Add(-time.Second),
+		Strikes: 1,
+	}
+
+	err := ReportBlocked("blog.naver.com", BlockReasonStatus, "HTTP 403")
+	if cooldown := time.Until(err.Until); cooldown <= 2*constants.BLOCK_COOLDOWN-time.Second {
+		t.Errorf("연속 차단 대기 시간 = %v, want %v", cooldown, 2*constants.BLOCK_COOLDOWN)
+	}
+	if hosts := BlockedHosts(); len(hosts) != 1 || hosts[0].Strikes != 2 {
+		t.Errorf("BlockedHosts() = %+v, want 연속 차단 2회", hosts)
+	}
+}
+
+func TestReportForbiddenNeedsRepeats(t *testing.T) {
+	ResetBlocked()
+	t.Cleanup(ResetBlocked)
+
+	// 403 한 번은 주소 하나의 문제일 수 있으므로 호스트를 차단하지 않음
+	for i := 1; i < constants.BLOCK_FORBIDDEN_THRESHOLD; i++ {
+		if err := ReportForbidden("blog.naver.com"); err != nil {
+			t.Fatalf("%d번째 403 ReportForbidden() = %v, want nil", i, err)
+		}
+	}
+	if err := CheckBlocked("blog.naver.com"); err != nil {
+		t.Fatalf("기준 전 CheckBlocked() = %v, want nil", err)
+	}
+
+	err := ReportForbidden("blog.naver.com")
+	if err == nil || err.Reason != BlockReasonStatus {
+		t.Fatalf("반복된 403 ReportForbidden() = %v, want status 차단", err)
+	}
+	if CheckBlocked("blog.naver.com") == nil {
+		t.Errorf("반복된 403 뒤 CheckBlocked() = nil, want 차단")
+	}
+
+	// 시간이 지난 403은 다시 처음부터 셈
+	ResetBlocked()
+	blockRegistry.forbidden["m.blog.naver.com"] = &forbiddenCount{count: constants.BLOCK_FORBIDDEN_THRESHOLD - 1, since: time.Now().Add(-2 * constants.BLOCK_FORBIDDEN_WINDOW)}
+	if err := ReportForbidden("m.blog.naver.com"); err != nil {
+		t.Errorf("기간이 지난 뒤 ReportForbidden() = %v, want nil", err)
+	}
+}
+
+func TestHostLimiterRefusesBlockedHost(t *testing.T) {
+	ResetBlocked()
+	t.Cleanup(ResetBlocked)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer server.Close()
+
+	ReportBlocked("127.0.0.1", BlockReasonStatus, "HTTP 429")
+
+	client := &http.Client{Transport: NewHostLimiter(nil, RetryPolicy{}, nil)}
+	_, err := client.Get(server.URL)
+
+	var blocked *BlockedError
+	if !errors.As(err, &blocked) {
+		t.Fatalf("차단된 호스트 요청 에러 = %v, want BlockedError", err)
+	}
+	if atomic.LoadInt32(&calls) != 0 {
+		t.Errorf("차단된 호스트로 요청이 전송되었습니다 (%d회)", calls)
+	}
+}
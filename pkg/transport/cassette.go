@@ -0,0 +1,225 @@
+package transport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// CassetteMode는 HTTP 요청 기록/재생 모드를 정의합니다
+type CassetteMode string
+
+const (
+	CassetteModeOff    CassetteMode = "off"    // 기록/재생 없이 네트워크 사용
+	CassetteModeRecord CassetteMode = "record" // 네트워크 응답을 디렉토리에 기록
+	CassetteModeReplay CassetteMode = "replay" // 기록된 응답만 사용 (네트워크 사용 안 함)
+)
+
+// ErrNotRecorded는 재생 모드에서 기록된 응답이 없을 때 반환됩니다
+var ErrNotRecorded = errors.New("기록된 응답이 없습니다")
+
+// 기록하지 않는 응답 헤더
+var skippedResponseHeaders = []string{"Set-Cookie"}
+
+// cassetteEntry는 하나의 요청/응답 기록입니다
+type cassetteEntry struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+	BodyBase64 bool        `json:"bodyBase64,omitempty"` // 본문이 UTF-8 텍스트가 아니면 base64로 저장
+	RecordedAt time.Time   `json:"recordedAt"`
+}
+
+// Cassette는 HTTP 요청/응답을 디렉토리에 기록하고 재생하는 RoundTripper입니다
+// 요청은 메서드, URL, 요청 본문으로 식별합니다
+type Cassette struct {
+	mode CassetteMode
+	dir  string
+	next http.RoundTripper
+	lock sync.Mutex
+}
+
+// NewCassette는 새 기록/재생 RoundTripper를 생성합니다
+// next가 nil이면 http.DefaultTransport를 사용합니다
+func NewCassette(mode CassetteMode, dir string, next http.RoundTripper) (*Cassette, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	switch mode {
+	case CassetteModeOff, CassetteModeReplay:
+	case CassetteModeRecord:
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("기록 디렉토리 생성 실패: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("지원하지 않는 기록 모드입니다: %s", mode)
+	}
+
+	return &Cassette{
+		mode: mode,
+		dir:  dir,
+		next: next,
+	}, nil
+}
+
+// Mode는 기록/재생 모드를 반환합니다
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// RoundTrip은 모드에 따라 요청을 네트워크로 보내거나 기록된 응답을 반환합니다
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch c.mode {
+	case CassetteModeReplay:
+		return c.replay(req)
+	case CassetteModeRecord:
+		return c.record(req)
+	default:
+		return c.next.RoundTrip(req)
+	}
+}
+
+// record는 네트워크 응답을 받아 기록한 뒤 반환합니다
+func (c *Cassette) record(req *http.Request) (*http.Response, error) {
+	key, err := requestKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("응답 본문 읽기 실패: %v", err)
+	}
+	// 호출자가 본문을 다시 읽을 수 있도록 복원
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	entry := cassetteEntry{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		RecordedAt: time.Now(),
+	}
+	for _, header := range skippedResponseHeaders {
+		entry.Header.Del(header)
+	}
+	if utf8.Valid(body) {
+		entry.Body = string(body)
+	} else {
+		entry.Body = base64.StdEncoding.EncodeToString(body)
+		entry.BodyBase64 = true
+	}
+
+	if err := c.save(key, entry); err != nil {
+		// 기록 실패는 요청 결과에 영향을 주지 않음
+		fmt.Printf("HTTP 응답 기록 실패 (무시됨): %v\n", err)
+	}
+	return resp, nil
+}
+
+// replay는 기록된 응답을 반환합니다
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	key, err := requestKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, req.Method, req.URL.String())
+		}
+		return nil, fmt.Errorf("기록 파일 읽기 실패: %v", err)
+	}
+
+	var entry cassetteEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("기록 파일 파싱 실패: %v", err)
+	}
+
+	body := []byte(entry.Body)
+	if entry.BodyBase64 {
+		if body, err = base64.StdEncoding.DecodeString(entry.Body); err != nil {
+			return nil, fmt.Errorf("기록된 본문 디코딩 실패: %v", err)
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode:    entry.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        entry.Header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// save는 기록을 파일로 저장합니다
+func (c *Cassette) save(key string, entry cassetteEntry) error {
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// path는 요청 키에 해당하는 기록 파일 경로를 반환합니다
+func (c *Cassette) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// requestKey는 메서드, URL, 요청 본문으로 요청을 식별하는 키({호스트}/{해시})를 생성합니다
+// 요청 본문을 읽은 경우 다시 읽을 수 있도록 복원합니다
+func requestKey(req *http.Request) (string, error) {
+	hash := sha256.New()
+	hash.Write([]byte(req.Method + " " + req.URL.String()))
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return "", fmt.Errorf("요청 본문 읽기 실패: %v", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		hash.Write([]byte("\n"))
+		hash.Write(body)
+	}
+
+	host := strings.ReplaceAll(req.URL.Host, ":", "_")
+	if host == "" {
+		host = "unknown"
+	}
+	return filepath.Join(host, hex.EncodeToString(hash.Sum(nil))[:32]), nil
+}
@@ -0,0 +1,83 @@
+package transport
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Set-Cookie", "session=secret")
+		io.WriteString(w, "<p>본문 "+r.URL.Query().Get("id")+"</p>")
+	}))
+
+	recorder, err := NewCassette(CassetteModeRecord, dir, nil)
+	if err != nil {
+		t.Fatalf("NewCassette(record) 실패: %v", err)
+	}
+	recorded := fetchBody(t, &http.Client{Transport: recorder}, server.URL+"?id=1")
+	if recorded != "<p>본문 1</p>" {
+		t.Fatalf("기록 중 응답 본문 = %q", recorded)
+	}
+
+	// 네트워크 없이 재생되는지 확인
+	server.Close()
+
+	player, err := NewCassette(CassetteModeReplay, dir, nil)
+	if err != nil {
+		t.Fatalf("NewCassette(replay) 실패: %v", err)
+	}
+	client := &http.Client{Transport: player}
+
+	resp, err := client.Get(server.URL + "?id=1")
+	if err != nil {
+		t.Fatalf("재생 실패: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != recorded {
+		t.Errorf("재생 본문 = %q, 기대값 %q", body, recorded)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("재생 상태 코드 = %d", resp.StatusCode)
+	}
+	if resp.Header.Get("Content-Type") != "text/html; charset=utf-8" {
+		t.Errorf("재생 Content-Type = %q", resp.Header.Get("Content-Type"))
+	}
+	if resp.Header.Get("Set-Cookie") != "" {
+		t.Errorf("Set-Cookie 헤더가 기록되었습니다: %q", resp.Header.Get("Set-Cookie"))
+	}
+
+	// 기록되지 않은 요청은 ErrNotRecorded
+	_, err = client.Get(server.URL + "?id=2")
+	if !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("기록되지 않은 요청 에러 = %v, 기대값 ErrNotRecorded", err)
+	}
+}
+
+func TestNewCassetteInvalidMode(t *testing.T) {
+	if _, err := NewCassette("rewind", t.TempDir(), nil); err == nil {
+		t.Error("지원하지 않는 모드에서 에러가 반환되지 않았습니다")
+	}
+}
+
+func fetchBody(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("요청 실패: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("본문 읽기 실패: %v", err)
+	}
+	return string(body)
+}
@@ -0,0 +1,153 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sh5080/ndns-go/pkg/configs"
+)
+
+var (
+	defaultTransport http.RoundTripper
+	directTransport  http.RoundTripper
+	defaultLock      sync.Mutex
+)
+
+// DefaultTransport는 서비스의 모든 HTTP 클라이언트가 공유하는 RoundTripper를 반환합니다
+// 처음 호출될 때 HTTP_HOST_LIMITS, HTTP_MAX_RETRIES, HTTP_EGRESS_ROUTES, HTTP_CASSETTE_MODE 설정에 따라 구성합니다
+func DefaultTransport() http.RoundTripper {
+	defaultLock.Lock()
+	defer defaultLock.Unlock()
+
+	if defaultTransport == nil {
+		defaultTransport = newConfiguredTransport()
+	}
+	return defaultTransport
+}
+
+// SetDefaultTransport는 기본 RoundTripper를 교체합니다 (테스트 등)
+// nil을 전달하면 다음 호출 시 설정에 따라 다시 구성합니다
+func SetDefaultTransport(rt http.RoundTripper) {
+	defaultLock.Lock()
+	defer defaultLock.Unlock()
+
+	defaultTransport = rt
+}
+
+// DirectTransport는 호스트 요청 제한과 요청 경로(RoutePool)를 거치지 않는 RoundTripper를 반환합니다
+// 원격 OCR 서비스 같은 내부 서비스 호출에 사용하며, 기록/재생 모드에서는 Cassette를 거칩니다
+func DirectTransport() http.RoundTripper {
+	defaultLock.Lock()
+	defer defaultLock.Unlock()
+
+	if directTransport == nil {
+		directTransport = newCassetteTransport(configs.GetConfig(), http.DefaultTransport)
+	}
+	return directTransport
+}
+
+// SetDirectTransport는 내부 서비스용 RoundTripper를 교체합니다 (테스트 등)
+// nil을 전달하면 다음 호출 시 설정에 따라 다시 구성합니다
+func SetDirectTransport(rt http.RoundTripper) {
+	defaultLock.Lock()
+	defer defaultLock.Unlock()
+
+	directTransport = rt
+}
+
+// NewDirectClient는 내부 서비스용 RoundTripper를 사용하는 HTTP 클라이언트를 생성합니다
+// timeout은 요청 전체에 적용되며, 0이면 요청 컨텍스트의 제한만 적용합니다
+func NewDirectClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: DirectTransport(),
+		Timeout:   timeout,
+	}
+}
+
+// NewClient는 기본 RoundTripper를 사용하는 HTTP 클라이언트를 생성합니다
+// timeout은 요청 한 번(재시도 포함 각 시도)에 적용되며, 호스트 요청 제한으로 대기하는 시간은 포함하지 않습니다
+func NewClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: &timeoutTransport{timeout: timeout, next: DefaultTransport()},
+	}
+}
+
+// attemptTimeoutKey는 요청별 시도 타임아웃을 저장하는 컨텍스트 키입니다
+type attemptTimeoutKey struct{}
+
+// timeoutTransport는 요청 컨텍스트에 시도 타임아웃을 기록하는 RoundTripper입니다
+// 타임아웃은 HostLimiter가 대기를 마치고 요청을 보낼 때 적용합니다 (RoutePool을 거치면 경로마다 적용)
+type timeoutTransport struct {
+	timeout time.Duration
+	next    http.RoundTripper
+}
+
+// RoundTrip은 시도 타임아웃을 기록한 요청을 다음 RoundTripper로 전달합니다
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout > 0 {
+		req = req.WithContext(context.WithValue(req.Context(), attemptTimeoutKey{}, t.timeout))
+	}
+	return t.next.RoundTrip(req)
+}
+
+// attemptTimeoutApplier는 시도 타임아웃을 직접 적용하는 RoundTripper입니다
+// HostLimiter는 다음 RoundTripper가 이 인터페이스를 구현하면 타임아웃을 적용하지 않고 그대로 전달합니다
+type attemptTimeoutApplier interface {
+	appliesAttemptTimeout()
+}
+
+// withAttemptTimeout은 요청 컨텍스트에 기록된 시도 타임아웃을 적용한 요청을 반환합니다
+// 반환된 cancel은 응답 본문을 모두 사용한 뒤 호출해야 합니다
+func withAttemptTimeout(req *http.Request) (*http.Request, context.CancelFunc) {
+	timeout, ok := req.Context().Value(attemptTimeoutKey{}).(time.Duration)
+	if !ok || timeout <= 0 {
+		return req, func() {}
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// newConfiguredTransport는 설정에 따라 RoundTripper를 생성합니다
+// 네트워크 요청은 항상 HostLimiter와 RoutePool을 거치며, 기록/재생 모드에서는 그 앞에 Cassette를 둡니다
+func newConfiguredTransport() http.RoundTripper {
+	config := configs.GetConfig()
+
+	rules, err := ParseHostRules(config.HTTP.HostLimits)
+	if err != nil {
+		fmt.Printf("호스트 요청 제한 설정 실패 (기본 제한 사용): %v\n", err)
+		rules = nil
+	}
+
+	routes, err := ParseEgressRoutes(config.HTTP.EgressRoutes, config.Server.WorkerURL)
+	if err != nil {
+		fmt.Printf("요청 경로 설정 실패 (직접 요청만 사용): %v\n", err)
+		routes = nil
+	}
+	pool := NewRoutePool(routes, http.DefaultTransport)
+	limiter := NewHostLimiter(rules, DefaultRetryPolicy(config.HTTP.MaxRetries), pool)
+
+	rt := newCassetteTransport(config, limiter)
+	if cassette, ok := rt.(*Cassette); ok {
+		fmt.Printf("HTTP 기록/재생 모드: %s (디렉토리: %s)\n", cassette.Mode(), config.HTTP.CassetteDir)
+	}
+	return rt
+}
+
+// newCassetteTransport는 기록/재생 모드이면 next 앞에 Cassette를 둔 RoundTripper를, 아니면 next를 반환합니다
+func newCassetteTransport(config *configs.EnvConfig, next http.RoundTripper) http.RoundTripper {
+	mode := CassetteMode(config.HTTP.CassetteMode)
+	if mode == "" || mode == CassetteModeOff {
+		return next
+	}
+
+	cassette, err := NewCassette(mode, config.HTTP.CassetteDir, next)
+	if err != nil {
+		fmt.Printf("HTTP 기록/재생 설정 실패 (네트워크 직접 사용): %v\n", err)
+		return next
+	}
+	return cassette
+}
@@ -0,0 +1,378 @@
+package transport
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+	"github.com/sh5080/ndns-go/pkg/utils"
+)
+
+// RouteKind는 외부 요청을 내보내는 경로의 종류입니다
+type RouteKind string
+
+const (
+	RouteKindDirect RouteKind = "direct" // 서버에서 대상 주소로 직접 요청
+	RouteKindWorker RouteKind = "worker" // 워커 프록시("워커주소?url=대상주소")를 거쳐 요청
+)
+
+// EgressRoute는 외부 요청을 내보내는 경로 하나입니다
+type EgressRoute struct {
+	Name string
+	Kind RouteKind
+	URL  string // 워커 프록시 주소 (direct는 빈 값)
+}
+
+// DirectRoute는 대상 주소로 직접 요청하는 경로를 반환합니다
+func DirectRoute() EgressRoute {
+	return EgressRoute{Name: string(RouteKindDirect), Kind: RouteKindDirect}
+}
+
+// WorkerRoute는 워커 프록시를 거쳐 요청하는 경로를 반환합니다
+func WorkerRoute(name, workerURL string) EgressRoute {
+	return EgressRoute{Name: name, Kind: RouteKindWorker, URL: workerURL}
+}
+
+// ParseEgressRoutes는 "direct" 또는 "이름=워커주소"를 쉼표로 구분한 경로 목록을 파싱합니다
+// 주소 없이 "worker"만 지정하면 workerURL(WORKER_URL)을 사용합니다 (예: "direct,worker,backup=https://w2.example.com")
+func ParseEgressRoutes(value, workerURL string) ([]EgressRoute, error) {
+	var routes []EgressRoute
+	names := make(map[string]bool)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, address, found := strings.Cut(item, "=")
+		name = strings.TrimSpace(name)
+		address = strings.TrimSpace(address)
+
+		var route EgressRoute
+		switch {
+		case !found && name == string(RouteKindDirect):
+			route = DirectRoute()
+		case !found && name == string(RouteKindWorker):
+			if workerURL == "" {
+				return nil, fmt.Errorf("워커 주소가 설정되지 않았습니다: %q", item)
+			}
+			route = WorkerRoute(name, workerURL)
+		case found && name != "" && address != "":
+			parsed, err := url.Parse(address)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				return nil, fmt.Errorf("잘못된 워커 주소입니다: %q", item)
+			}
+			route = WorkerRoute(name, address)
+		default:
+			return nil, fmt.Errorf("잘못된 요청 경로입니다: %q", item)
+		}
+
+		if names[route.Name] {
+			return nil, fmt.Errorf("요청 경로 이름이 중복되었습니다: %q", route.Name)
+		}
+		names[route.Name] = true
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// routeURLs는 실행 중에 바뀌는 워커 경로 주소입니다 (ngrok 터널 등, 경로 이름별)
+var routeURLs = struct {
+	urls map[string]string
+	lock sync.RWMutex
+}{urls: make(map[string]string)}
+
+// SetRouteURL은 이름이 name인 워커 경로의 주소를 바꿉니다
+// 프로세스의 모든 RoutePool에 다음 요청부터 적용되며, 빈 값을 전달하면 설정한 주소로 되돌립니다
+func SetRouteURL(name, workerURL string) {
+	routeURLs.lock.Lock()
+	defer routeURLs.lock.Unlock()
+
+	if workerURL == "" {
+		delete(routeURLs.urls, name)
+		return
+	}
+	routeURLs.urls[name] = workerURL
+}
+
+// CurrentURL은 워커 경로의 현재 주소를 반환합니다 (SetRouteURL로 바꾼 주소 우선)
+func (r EgressRoute) CurrentURL() string {
+	if r.Kind == RouteKindDirect {
+		return ""
+	}
+
+	routeURLs.lock.RLock()
+	defer routeURLs.lock.RUnlock()
+
+	if workerURL, exists := routeURLs.urls[r.Name]; exists {
+		return workerURL
+	}
+	return r.URL
+}
+
+// rewrite는 요청을 경로에 맞게 바꾼 새 요청을 반환합니다
+// 워커 경로는 대상 주소를 url 쿼리 파라미터로 전달하며, 헤더는 그대로 유지합니다
+func (r EgressRoute) rewrite(req *http.Request) (*http.Request, error) {
+	if r.Kind == RouteKindDirect {
+		return req, nil
+	}
+
+	workerURL, err := url.Parse(r.URL)
+	if err != nil {
+		return nil, fmt.Errorf("워커 주소 해석 실패 (%s): %v", r.Name, err)
+	}
+	query := workerURL.Query()
+	query.Set("url", req.URL.String())
+	workerURL.RawQuery = query.Encode()
+
+	routeReq := req.Clone(req.Context())
+	routeReq.URL = workerURL
+	routeReq.Host = ""
+	return routeReq, nil
+}
+
+// RouteStatus는 요청 경로의 현재 상태입니다
+type RouteStatus struct {
+	Route     EgressRoute
+	Health    float64       // 최근 요청 성공률 지수 이동 평균 (0~1)
+	Latency   time.Duration // 최근 응답 시간 지수 이동 평균
+	Requests  int
+	Failures  int
+	DownUntil time.Time // 이 시각까지 다른 경로를 모두 시도한 뒤에만 사용
+}
+
+// routeState는 요청 경로 하나의 상태입니다
+type routeState struct {
+	route  EgressRoute // 설정한 경로 (status.Route.URL은 마지막으로 사용한 주소)
+	status RouteStatus
+	trips  int // 연속으로 사용 중지된 횟수 (사용 중지 시간은 2배씩 증가)
+}
+
+// routeCandidate는 요청 하나에서 시도할 경로와 그 시점의 주소입니다
+type routeCandidate struct {
+	state *routeState
+	route EgressRoute
+}
+
+// RoutePool은 여러 외부 요청 경로(직접, 워커 프록시) 중 상태가 좋은 경로로 요청을 보내는 RoundTripper입니다
+// 경로는 설정한 순서대로 우선하며, 성공률이 EGRESS_MIN_HEALTH 아래로 떨어진 경로는 잠시 뒤로 미룹니다
+// 연결 실패, 시간 초과, EGRESS_FAILOVER_STATUS_CODES 응답은 다음 경로로 바로 다시 보내며, 시도 타임아웃은 경로마다 따로 적용합니다
+// 대상 서버의 차단(403, 429), 서버 오류(5xx) 응답은 대상 호스트 문제일 수 있으므로 다음 경로로 보내기만 하고 경로 성공률에는 반영하지 않습니다
+// 경로 성공률은 연결 실패, 시간 초과, 워커 프록시 자체 오류(EGRESS_WORKER_ERROR_HEADERS)로만 낮아집니다
+type RoutePool struct {
+	routes []*routeState
+	next   http.RoundTripper
+	lock   sync.Mutex
+	now    func() time.Time
+}
+
+// NewRoutePool은 새 요청 경로 풀을 생성합니다
+// 경로를 지정하지 않으면 직접 요청 경로만 사용합니다
+func NewRoutePool(routes []EgressRoute, next http.RoundTripper) *RoutePool {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if len(routes) == 0 {
+		routes = []EgressRoute{DirectRoute()}
+	}
+
+	pool := &RoutePool{
+		next: next,
+		now:  time.Now,
+	}
+	for _, route := range routes {
+		pool.routes = append(pool.routes, &routeState{route: route, status: RouteStatus{Route: route, Health: 1}})
+		utils.RecordEgressHealth(route.Name, 1)
+	}
+	return pool
+}
+
+// RoundTrip은 상태가 좋은 경로부터 요청을 보내고, 경로 실패이면 다음 경로로 다시 보냅니다
+// 본문이 있거나 GET/HEAD가 아닌 요청은 다른 경로로 다시 보내지 않습니다
+// 인증 헤더(EGRESS_DIRECT_ONLY_HEADERS)가 있는 요청은 API 키가 워커 프록시로 새지 않도록 직접 요청 경로로만 보냅니다
+func (p *RoutePool) RoundTrip(req *http.Request) (*http.Response, error) {
+	routes := p.order()
+	if hasCredentialHeader(req) {
+		routes = slices.DeleteFunc(routes, func(candidate routeCandidate) bool {
+			return candidate.route.Kind != RouteKindDirect
+		})
+		if len(routes) == 0 {
+			return nil, fmt.Errorf("인증 헤더가 있는 요청을 보낼 직접 요청 경로가 없습니다 (%s)", req.URL.Host)
+		}
+	}
+	if !isRetryableRequest(req) {
+		routes = routes[:1]
+	}
+
+	for i, candidate := range routes {
+		state, route := candidate.state, candidate.route
+		routeReq, err := route.rewrite(req)
+		if err != nil {
+			return nil, err
+		}
+
+		routeReq, cancel := withAttemptTimeout(routeReq)
+		start := p.now()
+		resp, err := p.next.RoundTrip(routeReq)
+		elapsed := p.now().Sub(start)
+
+		result := routeResultOf(route, resp, err)
+		p.report(state, result, elapsed)
+
+		// 성공했거나, 마지막 경로이거나, 요청 시간이 끝났으면 결과를 그대로 반환
+		if result == routeSuccess || i == len(routes)-1 || req.Context().Err() != nil {
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			// 본문을 닫을 때 시도 타임아웃 해제
+			resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: cancel}
+			return resp, nil
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+			fmt.Printf("요청 경로 전환 (%s, %s: HTTP %d)\n", req.URL.Host, route.Name, resp.StatusCode)
+		} else {
+			fmt.Printf("요청 경로 전환 (%s, %s: %v)\n", req.URL.Host, route.Name, err)
+		}
+		cancel()
+	}
+	// routes는 항상 하나 이상이므로 도달하지 않음
+	return nil, fmt.Errorf("사용할 수 있는 요청 경로가 없습니다")
+}
+
+// hasCredentialHeader는 요청에 워커 프록시로 보내지 않는 인증 헤더가 있는지 확인합니다
+func hasCredentialHeader(req *http.Request) bool {
+	return slices.ContainsFunc(constants.EGRESS_DIRECT_ONLY_HEADERS, func(header string) bool {
+		return req.Header.Get(header) != ""
+	})
+}
+
+// appliesAttemptTimeout은 RoutePool이 시도 타임아웃을 경로마다 직접 적용함을 나타냅니다
+func (p *RoutePool) appliesAttemptTimeout() {}
+
+// Routes는 요청 경로별 현재 상태를 설정 순서대로 반환합니다
+func (p *RoutePool) Routes() []RouteStatus {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	statuses := make([]RouteStatus, len(p.routes))
+	for i, state := range p.routes {
+		statuses[i] = state.status
+	}
+	return statuses
+}
+
+// order는 요청을 시도할 경로 순서를 반환합니다
+// 사용 중인 경로를 설정 순서대로 먼저, 사용 중지된 경로를 다시 사용할 수 있게 되는 순서대로 뒤에 둡니다
+// 워커 주소가 바뀐 경로(터널 재시작 등)는 이전 주소의 상태를 버리고 새로 시작합니다
+func (p *RoutePool) order() []routeCandidate {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := p.now()
+	var available, down []routeCandidate
+	for _, state := range p.routes {
+		if current := state.route.CurrentURL(); current != state.status.Route.URL {
+			fmt.Printf("요청 경로 주소 변경: %s (%s → %s)\n", state.route.Name, state.status.Route.URL, current)
+			state.status.Route.URL = current
+			state.status.Health = 1
+			state.status.DownUntil = time.Time{}
+			state.trips = 0
+			utils.RecordEgressHealth(state.route.Name, 1)
+		}
+
+		candidate := routeCandidate{state: state, route: state.status.Route}
+		if now.Before(state.status.DownUntil) {
+			down = append(down, candidate)
+		} else {
+			available = append(available, candidate)
+		}
+	}
+
+	sort.SliceStable(down, func(i, j int) bool {
+		return down[i].state.status.DownUntil.Before(down[j].state.status.DownUntil)
+	})
+	return append(available, down...)
+}
+
+// report는 경로의 요청 결과로 성공률과 응답 시간을 갱신합니다
+// 성공률이 EGRESS_MIN_HEALTH 아래로 떨어지면 경로 사용을 잠시 멈추고, 다시 사용할 때는 성공률을 기준값에서 시작합니다
+func (p *RoutePool) report(state *routeState, result routeResult, elapsed time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := &state.status
+	status.Requests++
+	if status.Latency == 0 {
+		status.Latency = elapsed
+	} else {
+		status.Latency = time.Duration(float64(status.Latency)*(1-constants.EGRESS_HEALTH_WEIGHT) + float64(elapsed)*constants.EGRESS_HEALTH_WEIGHT)
+	}
+
+	switch result {
+	case routeSuccess:
+		status.Health = status.Health*(1-constants.EGRESS_HEALTH_WEIGHT) + constants.EGRESS_HEALTH_WEIGHT
+		state.trips = 0
+	case routeFailure:
+		status.Health = status.Health * (1 - constants.EGRESS_HEALTH_WEIGHT)
+		status.Failures++
+		if status.Health < constants.EGRESS_MIN_HEALTH && !p.now().Before(status.DownUntil) {
+			state.trips++
+			cooldown := constants.EGRESS_ROUTE_COOLDOWN << (state.trips - 1)
+			if cooldown <= 0 || cooldown > constants.EGRESS_ROUTE_MAX_COOLDOWN {
+				cooldown = constants.EGRESS_ROUTE_MAX_COOLDOWN
+			}
+			status.DownUntil = p.now().Add(cooldown)
+			// 사용 중지가 끝난 뒤 한 번 더 실패하면 바로 다시 멈추도록 기준값에서 시작
+			status.Health = constants.EGRESS_MIN_HEALTH
+			fmt.Printf("요청 경로 사용 중지: %s, %v 동안 다른 경로 우선\n", status.Route.Name, cooldown)
+		}
+	}
+
+	utils.RecordEgressRequest(status.Route.Name, string(result), elapsed.Seconds())
+	utils.RecordEgressHealth(status.Route.Name, status.Health)
+}
+
+// routeResult는 경로 하나로 보낸 요청의 결과입니다
+type routeResult string
+
+const (
+	routeSuccess  routeResult = "success"        // 응답을 그대로 사용
+	routeFailure  routeResult = "failure"        // 연결 실패, 워커 프록시 자체 오류 (경로 성공률에 반영)
+	routeBlocked  routeResult = "blocked"        // 차단 응답 (다음 경로로 보내지만 성공률에는 반영하지 않음)
+	routeUpstream routeResult = "upstream_error" // 대상 서버의 오류 응답 (다음 경로로 보내지만 성공률에는 반영하지 않음)
+)
+
+// routeResultOf는 요청 결과가 경로 문제(연결 실패, 워커 프록시 오류)인지, 대상 서버의 응답(차단, 서버 오류)인지 판별합니다
+// 대상 서버 하나의 오류로 모든 호스트가 쓰는 경로를 사용 중지하지 않도록 대상 서버의 응답은 경로 실패로 기록하지 않습니다
+func routeResultOf(route EgressRoute, resp *http.Response, err error) routeResult {
+	switch {
+	case err != nil:
+		return routeFailure
+	case !slices.Contains(constants.EGRESS_FAILOVER_STATUS_CODES, resp.StatusCode):
+		return routeSuccess
+	case route.Kind == RouteKindWorker && isWorkerError(resp):
+		return routeFailure
+	case resp.StatusCode == http.StatusForbidden || slices.Contains(constants.BLOCK_STATUS_CODES, resp.StatusCode):
+		return routeBlocked
+	default:
+		return routeUpstream
+	}
+}
+
+// isWorkerError는 응답이 대상 서버가 아닌 워커 프록시(터널 포함) 자체의 오류 응답인지 확인합니다
+func isWorkerError(resp *http.Response) bool {
+	return slices.ContainsFunc(constants.EGRESS_WORKER_ERROR_HEADERS, func(header string) bool {
+		return resp.Header.Get(header) != ""
+	})
+}
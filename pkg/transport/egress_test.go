@@ -0,0 +1,305 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sh5080/ndns-go/pkg/transport/transporttest"
+	constants "github.com/sh5080/ndns-go/pkg/types"
+)
+
+func TestParseEgressRoutes(t *testing.T) {
+	routes, err := ParseEgressRoutes("direct, worker,backup=https://w2.example.com/fetch,", "https://w1.example.com")
+	if err != nil {
+		t.Fatalf("ParseEgressRoutes 실패: %v", err)
+	}
+
+	want := []EgressRoute{
+		{Name: "direct", Kind: RouteKindDirect},
+		{Name: "worker", Kind: RouteKindWorker, URL: "https://w1.example.com"},
+		{Name: "backup", Kind: RouteKindWorker, URL: "https://w2.example.com/fetch"},
+	}
+	if !reflect.DeepEqual(routes, want) {
+		t.Errorf("ParseEgressRoutes()\n got: %+v\nwant: %+v", routes, want)
+	}
+
+	for _, invalid := range []string{"proxy", "a=", "=https://a.com", "a=ftp://a.com", "a=https://", "direct,direct"} {
+		if _, err := ParseEgressRoutes(invalid, "https://w1.example.com"); err == nil {
+			t.Errorf("ParseEgressRoutes(%q) 에러가 반환되지 않았습니다", invalid)
+		}
+	}
+	if _, err := ParseEgressRoutes("direct,worker", ""); err == nil {
+		t.Errorf("WORKER_URL 없이 worker 경로를 지정했는데 에러가 반환되지 않았습니다")
+	}
+}
+
+// newProxyOnlyTarget은 가짜 워커를 거치지 않은 요청을 status로 거부하는 대상 서버를 생성합니다
+// status가 0이면 응답 없이 연결을 끊습니다 (직접 경로의 연결 실패)
+func newProxyOnlyTarget(t *testing.T, status int, direct *int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(transporttest.ProxyHeader) == "" {
+			atomic.AddInt32(direct, 1)
+			if status == 0 {
+				conn, _, err := w.(http.Hijacker).Hijack()
+				if err == nil {
+					conn.Close()
+				}
+				return
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte("via worker: " + r.Header.Get("Referer")))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func get(t *testing.T, rt http.RoundTripper, target string) *http.Response {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, target, nil)
+	req.Header.Set("Referer", "https://blog.naver.com/")
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("요청 실패: %v", err)
+	}
+	return resp
+}
+
+func TestRoutePoolFailsOverToWorker(t *testing.T) {
+	worker := transporttest.NewFakeWorker()
+	defer worker.Close()
+
+	var direct int32
+	target := newProxyOnlyTarget(t, http.StatusBadGateway, &direct)
+	pool := NewRoutePool([]EgressRoute{DirectRoute(), WorkerRoute("worker", worker.URL)}, http.DefaultTransport)
+
+	resp := get(t, pool, target.URL+"/image.png")
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	// 헤더는 워커를 거쳐도 그대로 전달
+	if resp.StatusCode != http.StatusOK || string(body) != "via worker: https://blog.naver.com/" {
+		t.Fatalf("응답 = HTTP %d %q, want 워커를 거친 200 응답", resp.StatusCode, body)
+	}
+	if direct != 1 || worker.Requests() != 1 {
+		t.Errorf("직접 요청 %d번, 워커 요청 %d번, want 1, 1", direct, worker.Requests())
+	}
+
+	// 대상 서버의 오류 응답은 다른 경로로 보내기만 하고 직접 경로 성공률에는 반영하지 않음
+	for i := 0; i < 3; i++ {
+		get(t, pool, target.URL+"/image.png").Body.Close()
+	}
+	routes := pool.Routes()
+	if routes[0].Failures != 0 || routes[0].Health != 1 || !routes[0].DownUntil.IsZero() {
+		t.Errorf("직접 경로 상태 = %+v, want 대상 서버 오류로 성공률 유지", routes[0])
+	}
+	if routes[1].Health != 1 || routes[1].Requests != 4 || routes[1].Latency <= 0 {
+		t.Errorf("워커 경로 상태 = %+v, want 성공 4번 기록", routes[1])
+	}
+}
+
+func TestRoutePoolCooldownAndRecovery(t *testing.T) {
+	worker := transporttest.NewFakeWorker()
+	defer worker.Close()
+
+	// 직접 경로의 연결 실패는 경로 성공률에 반영
+	var direct int32
+	target := newProxyOnlyTarget(t, 0, &direct)
+	pool := NewRoutePool([]EgressRoute{DirectRoute(), WorkerRoute("worker", worker.URL)}, http.DefaultTransport)
+	now := time.Now()
+	pool.now = func() time.Time { return now }
+
+	// 성공률이 EGRESS_MIN_HEALTH 아래로 떨어질 때까지 직접 경로 실패
+	for i := 0; pool.Routes()[0].DownUntil.IsZero(); i++ {
+		if i > 10 {
+			t.Fatalf("직접 경로가 사용 중지되지 않았습니다: %+v", pool.Routes()[0])
+		}
+		get(t, pool, target.URL).Body.Close()
+	}
+	failed := direct
+
+	// 사용 중지된 동안은 워커로 바로 요청
+	get(t, pool, target.URL).Body.Close()
+	if direct != failed {
+		t.Errorf("사용 중지된 직접 경로로 요청했습니다 (%d번 → %d번)", failed, direct)
+	}
+
+	// 사용 중지 시간이 끝나면 다시 직접 경로 우선
+	now = now.Add(constants.EGRESS_ROUTE_COOLDOWN)
+	get(t, pool, target.URL).Body.Close()
+	if direct <= failed {
+		t.Errorf("사용 중지 후 직접 경로를 다시 시도하지 않았습니다")
+	}
+
+	// 다시 실패하면 바로 사용 중지되고 대기 시간은 2배
+	status := pool.Routes()[0]
+	if want := now.Add(2 * constants.EGRESS_ROUTE_COOLDOWN); !status.DownUntil.Equal(want) {
+		t.Errorf("두 번째 사용 중지 = %v, want %v", status.DownUntil, want)
+	}
+}
+
+func TestRoutePoolBlockedStatusKeepsHealth(t *testing.T) {
+	worker := transporttest.NewFakeWorker()
+	defer worker.Close()
+
+	var direct int32
+	target := newProxyOnlyTarget(t, http.StatusForbidden, &direct)
+	pool := NewRoutePool([]EgressRoute{DirectRoute(), WorkerRoute("worker", worker.URL)}, http.DefaultTransport)
+
+	// 대상 호스트의 차단 응답은 워커로 다시 보내지만 직접 경로는 계속 사용
+	for i := 0; i < 3; i++ {
+		resp := get(t, pool, target.URL)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("응답 = HTTP %d, want 200", resp.StatusCode)
+		}
+	}
+	if direct != 3 || pool.Routes()[0].Health != 1 {
+		t.Errorf("직접 요청 %d번, 상태 %+v, want 3번 시도하고 성공률 유지", direct, pool.Routes()[0])
+	}
+}
+
+func TestRoutePoolReturnsLastFailure(t *testing.T) {
+	worker := transporttest.NewFakeWorker()
+	defer worker.Close()
+	worker.SetFailing(true)
+
+	var direct int32
+	target := newProxyOnlyTarget(t, http.StatusServiceUnavailable, &direct)
+	pool := NewRoutePool([]EgressRoute{DirectRoute(), WorkerRoute("worker", worker.URL)}, http.DefaultTransport)
+
+	resp := get(t, pool, target.URL)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadGateway || !strings.Contains(string(body), "worker unavailable") {
+		t.Errorf("응답 = HTTP %d %q, want 마지막 경로의 502 응답", resp.StatusCode, body)
+	}
+
+	// 본문이 있는 요청은 다른 경로로 다시 보내지 않음
+	req, _ := http.NewRequest(http.MethodPost, target.URL, strings.NewReader("payload"))
+	resp, err := pool.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("요청 실패: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || worker.Requests() != 1 {
+		t.Errorf("POST 응답 = HTTP %d, 워커 요청 %d번, want 503, 1번", resp.StatusCode, worker.Requests())
+	}
+}
+
+func TestRoutePoolKeepsCredentialsOffWorker(t *testing.T) {
+	worker := transporttest.NewFakeWorker()
+	defer worker.Close()
+
+	var direct int32
+	target := newProxyOnlyTarget(t, http.StatusServiceUnavailable, &direct)
+	pool := NewRoutePool([]EgressRoute{DirectRoute(), WorkerRoute("worker", worker.URL)}, http.DefaultTransport)
+
+	// 네이버 검색 API처럼 인증 헤더가 있는 요청은 직접 요청이 실패해도 워커로 보내지 않음
+	req, _ := http.NewRequest(http.MethodGet, target.URL+"/v1/search/blog.json", nil)
+	req.Header.Set("X-Naver-Client-Id", "client-id")
+	req.Header.Set("X-Naver-Client-Secret", "client-secret")
+	resp, err := pool.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("요청 실패: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || direct != 1 || worker.Requests() != 0 {
+		t.Errorf("응답 = HTTP %d, 직접 요청 %d번, 워커 요청 %d번, want 503, 1번, 0번", resp.StatusCode, direct, worker.Requests())
+	}
+
+	// 직접 요청 경로가 없으면 보내지 않음
+	workerOnly := NewRoutePool([]EgressRoute{WorkerRoute("worker", worker.URL)}, http.DefaultTransport)
+	if _, err := workerOnly.RoundTrip(req); err == nil {
+		t.Errorf("워커 경로만 있는데 인증 헤더가 있는 요청이 전송되었습니다")
+	}
+	if worker.Requests() != 0 {
+		t.Errorf("워커 요청 %d번, want 0번", worker.Requests())
+	}
+}
+
+func TestRoutePoolAppliesTimeoutPerRoute(t *testing.T) {
+	worker := transporttest.NewFakeWorker()
+	defer worker.Close()
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(transporttest.ProxyHeader) == "" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer slow.Close()
+
+	pool := NewRoutePool([]EgressRoute{DirectRoute(), WorkerRoute("worker", worker.URL)}, http.DefaultTransport)
+	limiter := NewHostLimiter(nil, RetryPolicy{}, pool)
+	client := &http.Client{Transport: &timeoutTransport{timeout: 100 * time.Millisecond, next: limiter}}
+
+	// 직접 경로가 시간 초과되어도 워커 경로는 새 타임아웃으로 시도
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, slow.URL, nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("요청 실패: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "ok" || worker.Requests() != 1 {
+		t.Errorf("응답 = %q, 워커 요청 %d번, want 워커를 거친 응답", body, worker.Requests())
+	}
+}
+
+func TestRoutePoolPicksUpNewWorkerURL(t *testing.T) {
+	rotated := transporttest.NewFakeWorker()
+	defer rotated.Close()
+	stale := transporttest.NewFakeWorker()
+	defer stale.Close()
+	stale.SetFailing(true)
+	defer SetRouteURL("worker", "")
+
+	var direct int32
+	target := newProxyOnlyTarget(t, http.StatusServiceUnavailable, &direct)
+	pool := NewRoutePool([]EgressRoute{WorkerRoute("worker", stale.URL), DirectRoute()}, http.DefaultTransport)
+
+	// 이전 주소의 워커는 자체 오류로 실패하여 사용 중지
+	for i := 0; pool.Routes()[0].DownUntil.IsZero(); i++ {
+		if i > 10 {
+			t.Fatalf("워커 경로가 사용 중지되지 않았습니다: %+v", pool.Routes()[0])
+		}
+		get(t, pool, target.URL).Body.Close()
+	}
+	// 대신 요청한 직접 경로의 503은 대상 서버 오류이므로 직접 경로는 계속 사용
+	if status := pool.Routes()[1]; status.Health != 1 || !status.DownUntil.IsZero() {
+		t.Errorf("직접 경로 상태 = %+v, want 성공률 유지", status)
+	}
+
+	// 터널 주소가 바뀌면 사용 중지 상태를 버리고 새 주소로 바로 요청
+	SetRouteURL("worker", rotated.URL)
+	resp := get(t, pool, target.URL)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || rotated.Requests() != 1 {
+		t.Errorf("응답 = HTTP %d, 새 워커 요청 %d번, want 200, 1번", resp.StatusCode, rotated.Requests())
+	}
+	if status := pool.Routes()[0]; status.Route.URL != rotated.URL || !status.DownUntil.IsZero() {
+		t.Errorf("워커 경로 상태 = %+v, want 새 주소로 초기화", status)
+	}
+
+	// 빈 값이면 설정한 주소로 되돌림
+	SetRouteURL("worker", "")
+	if got := WorkerRoute("worker", stale.URL).CurrentURL(); got != stale.URL {
+		t.Errorf("CurrentURL() = %q, want %q", got, stale.URL)
+	}
+}
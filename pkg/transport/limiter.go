@@ -0,0 +1,388 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	constants "github.com/sh5080/ndns-go/pkg/types"
+)
+
+// HostRule은 호스트 패턴별 요청 제한 규칙입니다
+type HostRule struct {
+	Pattern     string  // 호스트 패턴 (blog.naver.com, *.pstatic.net, *)
+	Rate        float64 // 초당 요청 수
+	Burst       int     // 연속으로 보낼 수 있는 요청 수
+	MaxInFlight int     // 동시에 처리 중인 최대 요청 수
+}
+
+// Matches는 호스트가 규칙 패턴에 해당하는지 확인합니다
+// "*.example.com"은 example.com과 모든 하위 도메인에 해당합니다
+func (r HostRule) Matches(host string) bool {
+	host = strings.ToLower(host)
+	pattern := strings.ToLower(r.Pattern)
+
+	switch {
+	case pattern == "*":
+		return true
+	case strings.HasPrefix(pattern, "*."):
+		domain := pattern[2:]
+		return host == domain || strings.HasSuffix(host, "."+domain)
+	default:
+		return host == pattern
+	}
+}
+
+// DefaultHostRule은 어떤 규칙에도 해당하지 않는 호스트에 적용하는 기본 제한입니다
+func DefaultHostRule() HostRule {
+	return HostRule{
+		Pattern:     "*",
+		Rate:        constants.HTTP_DEFAULT_HOST_RATE,
+		Burst:       constants.HTTP_DEFAULT_HOST_BURST,
+		MaxInFlight: constants.HTTP_DEFAULT_HOST_MAX_IN_FLIGHT,
+	}
+}
+
+// ParseHostRules는 "패턴=초당요청수:버스트:동시요청수" 형식의 규칙 목록을 파싱합니다
+// 규칙은 쉼표로 구분합니다 (예: "blog.naver.com=5:10:4,*.pstatic.net=20:20:8")
+func ParseHostRules(value string) ([]HostRule, error) {
+	var rules []HostRule
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		pattern, limits, found := strings.Cut(item, "=")
+		parts := strings.Split(limits, ":")
+		if !found || pattern == "" || len(parts) != 3 {
+			return nil, fmt.Errorf("잘못된 요청 제한 규칙입니다: %q", item)
+		}
+
+		rate, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("잘못된 초당 요청 수입니다: %q", item)
+		}
+		burst, err := strconv.Atoi(parts[1])
+		if err != nil || burst <= 0 {
+			return nil, fmt.Errorf("잘못된 버스트 값입니다: %q", item)
+		}
+		maxInFlight, err := strconv.Atoi(parts[2])
+		if err != nil || maxInFlight <= 0 {
+			return nil, fmt.Errorf("잘못된 동시 요청 수입니다: %q", item)
+		}
+
+		rules = append(rules, HostRule{
+			Pattern:     strings.TrimSpace(pattern),
+			Rate:        rate,
+			Burst:       burst,
+			MaxInFlight: maxInFlight,
+		})
+	}
+	return rules, nil
+}
+
+// RetryPolicy는 실패한 요청의 재시도 정책입니다
+type RetryPolicy struct {
+	MaxRetries int           // 최대 재시도 횟수
+	BaseDelay  time.Duration // 첫 재시도 대기 시간 (재시도마다 2배씩 증가)
+	MaxDelay   time.Duration // 재시도 대기 시간 상한
+}
+
+// DefaultRetryPolicy는 기본 재시도 정책을 반환합니다
+func DefaultRetryPolicy(maxRetries int) RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: maxRetries,
+		BaseDelay:  constants.HTTP_RETRY_BASE_DELAY,
+		MaxDelay:   constants.HTTP_RETRY_MAX_DELAY,
+	}
+}
+
+// backoff는 재시도 횟수에 따른 지수 대기 시간에 지터를 적용하여 반환합니다
+// 대기 시간은 [지수 대기 시간/2, 지수 대기 시간] 범위에서 무작위로 정합니다
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << attempt
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// HostLimiter는 호스트별 요청 속도와 동시 요청 수를 제한하고 실패한 요청을 재시도하는 RoundTripper입니다
+// 요청 속도는 호스트별 토큰 버킷으로 제한하며, 서버가 429/503으로 응답하면 해당 호스트의 모든 요청을 잠시 멈춥니다
+// 차단이 감지된 호스트(ReportBlocked)로는 대기 시간이 끝날 때까지 요청을 보내지 않고 BlockedError를 반환합니다
+type HostLimiter struct {
+	rules    []HostRule
+	fallback HostRule
+	retry    RetryPolicy
+	next     http.RoundTripper
+	hosts    map[string]*hostState
+	lock     sync.Mutex
+	sleep    func(ctx context.Context, d time.Duration) error
+}
+
+// NewHostLimiter는 새 호스트별 요청 제한 RoundTripper를 생성합니다
+// 앞쪽 규칙이 우선하며, 해당하는 규칙이 없으면 DefaultHostRule을 적용합니다
+func NewHostLimiter(rules []HostRule, retry RetryPolicy, next http.RoundTripper) *HostLimiter {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &HostLimiter{
+		rules:    rules,
+		fallback: DefaultHostRule(),
+		retry:    retry,
+		next:     next,
+		hosts:    make(map[string]*hostState),
+		sleep:    sleepContext,
+	}
+}
+
+// RoundTrip은 호스트 제한에 따라 대기한 뒤 요청을 보내고, 일시적인 실패는 재시도합니다
+func (l *HostLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	// 차단 대기 중인 호스트로는 요청을 보내지 않음
+	if err := CheckBlocked(req.URL.Hostname()); err != nil {
+		return nil, err
+	}
+
+	state := l.host(req.URL.Hostname())
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if err := state.acquire(ctx, l.sleep); err != nil {
+			return nil, err
+		}
+
+		attemptReq, cancel := req, context.CancelFunc(func() {})
+		if _, ok := l.next.(attemptTimeoutApplier); !ok {
+			attemptReq, cancel = withAttemptTimeout(req)
+		}
+		resp, err := l.next.RoundTrip(attemptReq)
+
+		delay, retry := l.retryDelay(req, resp, err, attempt)
+		if !retry {
+			if err != nil {
+				cancel()
+				state.release()
+				return nil, err
+			}
+			// 본문을 닫을 때 동시 요청 슬롯을 반환
+			resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: func() {
+				cancel()
+				state.release()
+			}}
+			return resp, nil
+		}
+
+		if resp != nil {
+			// 서버가 요청한 대기 시간 동안 같은 호스트의 다른 요청도 멈춤
+			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+				state.pause(delay)
+			}
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+			fmt.Printf("요청 재시도 (%s, HTTP %d, %d번째, %v 후)\n", req.URL.Host, resp.StatusCode, attempt+1, delay)
+		} else {
+			fmt.Printf("요청 재시도 (%s, %v, %d번째, %v 후)\n", req.URL.Host, err, attempt+1, delay)
+		}
+		cancel()
+		state.release()
+
+		if err := l.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryDelay는 요청을 재시도할지와 재시도 전 대기 시간을 반환합니다
+// 본문이 없는 GET/HEAD 요청의 네트워크 오류와 429, 502, 503, 504 응답만 재시도합니다
+func (l *HostLimiter) retryDelay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= l.retry.MaxRetries || !isRetryableRequest(req) || req.Context().Err() != nil {
+		return 0, false
+	}
+
+	if err != nil {
+		return l.retry.backoff(attempt), true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+	default:
+		return 0, false
+	}
+
+	if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		// 서버가 요구한 대기 시간이 상한보다 길면 재시도하지 않고 응답을 그대로 반환
+		if wait > l.retry.MaxDelay {
+			return 0, false
+		}
+		return wait, true
+	}
+	return l.retry.backoff(attempt), true
+}
+
+// host는 호스트의 요청 제한 상태를 반환합니다
+func (l *HostLimiter) host(host string) *hostState {
+	host = strings.ToLower(host)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if state, exists := l.hosts[host]; exists {
+		return state
+	}
+
+	rule := l.fallback
+	for _, candidate := range l.rules {
+		if candidate.Matches(host) {
+			rule = candidate
+			break
+		}
+	}
+
+	state := newHostState(rule)
+	l.hosts[host] = state
+	return state
+}
+
+// hostState는 호스트 하나의 토큰 버킷과 동시 요청 슬롯입니다
+type hostState struct {
+	rule        HostRule
+	slots       chan struct{}
+	tokens      float64
+	updatedAt   time.Time
+	pausedUntil time.Time
+	lock        sync.Mutex
+}
+
+// newHostState는 버킷이 가득 찬 상태로 호스트 상태를 생성합니다
+func newHostState(rule HostRule) *hostState {
+	return &hostState{
+		rule:      rule,
+		slots:     make(chan struct{}, rule.MaxInFlight),
+		tokens:    float64(rule.Burst),
+		updatedAt: time.Now(),
+	}
+}
+
+// acquire는 토큰과 동시 요청 슬롯을 얻을 때까지 대기합니다
+// HTTP_MAX_QUEUE_WAIT 이상 기다려야 하면 오류를 반환합니다
+func (h *hostState) acquire(ctx context.Context, sleep func(context.Context, time.Duration) error) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.HTTP_MAX_QUEUE_WAIT)
+	defer cancel()
+
+	for {
+		wait := h.take()
+		if wait == 0 {
+			break
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return fmt.Errorf("요청 제한 대기 실패 (%s): %v", h.rule.Pattern, err)
+		}
+	}
+
+	select {
+	case h.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("동시 요청 슬롯 대기 실패 (%s): %v", h.rule.Pattern, ctx.Err())
+	}
+}
+
+// take는 토큰을 하나 사용하고 0을 반환합니다
+// 토큰이 없거나 호스트가 일시 중지된 상태이면 다시 시도할 때까지의 대기 시간을 반환합니다
+func (h *hostState) take() time.Duration {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	now := time.Now()
+	if now.Before(h.pausedUntil) {
+		return h.pausedUntil.Sub(now)
+	}
+
+	h.tokens = min(float64(h.rule.Burst), h.tokens+now.Sub(h.updatedAt).Seconds()*h.rule.Rate)
+	h.updatedAt = now
+
+	if h.tokens >= 1 {
+		h.tokens--
+		return 0
+	}
+	return time.Duration((1 - h.tokens) / h.rule.Rate * float64(time.Second))
+}
+
+// release는 동시 요청 슬롯을 반환합니다
+func (h *hostState) release() {
+	<-h.slots
+}
+
+// pause는 지정한 시간 동안 호스트의 새 요청을 멈춥니다
+func (h *hostState) pause(d time.Duration) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if until := time.Now().Add(d); until.After(h.pausedUntil) {
+		h.pausedUntil = until
+	}
+}
+
+// releaseOnClose는 응답 본문을 닫을 때 한 번만 release를 호출하는 ReadCloser입니다
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+// Close는 본문을 닫고 동시 요청 슬롯을 반환합니다
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
+
+// isRetryableRequest는 같은 요청을 다시 보내도 안전한지 확인합니다
+func isRetryableRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody
+}
+
+// parseRetryAfter는 Retry-After 헤더(초 또는 HTTP 날짜)를 대기 시간으로 변환합니다
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// sleepContext는 지정한 시간 동안 대기하며, 컨텍스트가 끝나면 즉시 반환합니다
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
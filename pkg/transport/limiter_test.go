@@ -0,0 +1,253 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseHostRules(t *testing.T) {
+	rules, err := ParseHostRules("blog.naver.com=5:10:4, *.pstatic.net=0.5:1:2,")
+	if err != nil {
+		t.Fatalf("ParseHostRules 실패: %v", err)
+	}
+
+	want := []HostRule{
+		{Pattern: "blog.naver.com", Rate: 5, Burst: 10, MaxInFlight: 4},
+		{Pattern: "*.pstatic.net", Rate: 0.5, Burst: 1, MaxInFlight: 2},
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("ParseHostRules()\n got: %+v\nwant: %+v", rules, want)
+	}
+
+	for _, invalid := range []string{"blog.naver.com", "blog.naver.com=5:10", "=5:10:4", "a.com=0:1:1", "a.com=1:x:1", "a.com=1:1:0"} {
+		if _, err := ParseHostRules(invalid); err == nil {
+			t.Errorf("ParseHostRules(%q) 에러가 반환되지 않았습니다", invalid)
+		}
+	}
+}
+
+func TestHostRuleMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		host    string
+		want    bool
+	}{
+		{"blog.naver.com", "blog.naver.com", true},
+		{"blog.naver.com", "m.blog.naver.com", false},
+		{"*.pstatic.net", "postfiles.pstatic.net", true},
+		{"*.pstatic.net", "pstatic.net", true},
+		{"*.pstatic.net", "notpstatic.net", false},
+		{"*", "example.com", true},
+	}
+
+	for _, tt := range tests {
+		if got := (HostRule{Pattern: tt.pattern}).Matches(tt.host); got != tt.want {
+			t.Errorf("HostRule{%q}.Matches(%q) = %v, want %v", tt.pattern, tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestHostLimiterMaxInFlight(t *testing.T) {
+	var current, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+	}))
+	defer server.Close()
+
+	rules := []HostRule{{Pattern: "127.0.0.1", Rate: 1000, Burst: 100, MaxInFlight: 2}}
+	client := &http.Client{Transport: NewHostLimiter(rules, RetryPolicy{}, nil)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("요청 실패: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("최대 동시 요청 수 = %d, want <= 2", peak)
+	}
+}
+
+func TestHostLimiterReleasesSlotOnNonOKResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	rules := []HostRule{{Pattern: "127.0.0.1", Rate: 1000, Burst: 100, MaxInFlight: 1}}
+	client := &http.Client{Transport: NewHostLimiter(rules, RetryPolicy{}, nil)}
+
+	// 슬롯이 새면 다음 요청이 기다리다 시간 초과로 실패
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	// 200이 아닌 HEAD 응답도 본문을 닫으면 슬롯을 반환 (이미지 크기 확인 요청)
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodHead, server.URL, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("HEAD 요청 실패 (슬롯 누수): %v", err)
+		}
+		if resp.StatusCode != http.StatusMethodNotAllowed {
+			t.Fatalf("HEAD 상태 코드 = %d, want 405", resp.StatusCode)
+		}
+		resp.Body.Close()
+	}
+
+	// 슬롯이 남아 있으면 GET 요청이 기다리지 않고 처리됨
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("HEAD 이후 GET 요청 실패 (슬롯 누수): %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestHostLimiterRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// 버스트 1, 초당 20회: 3번째 요청까지 최소 100ms 대기
+	rules := []HostRule{{Pattern: "127.0.0.1", Rate: 20, Burst: 1, MaxInFlight: 10}}
+	client := &http.Client{Transport: NewHostLimiter(rules, RetryPolicy{}, nil)}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("요청 실패: %v", err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("요청 3회 소요 시간 = %v, 요청 속도가 제한되지 않았습니다", elapsed)
+	}
+}
+
+func TestHostLimiterHonorsRetryAfter(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	limiter := NewHostLimiter(nil, RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Second}, nil)
+	var delays []time.Duration
+	limiter.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return sleepContext(ctx, d)
+	}
+
+	resp, err := (&http.Client{Transport: limiter}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("요청 실패: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("상태 코드 = %d, 호출 수 = %d, want 200, 2", resp.StatusCode, atomic.LoadInt32(&calls))
+	}
+	if len(delays) == 0 || delays[0] != time.Second {
+		t.Errorf("재시도 대기 시간 = %v, want 첫 대기 1s", delays)
+	}
+}
+
+func TestHostLimiterRetryBackoff(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+	}))
+	defer server.Close()
+
+	limiter := NewHostLimiter(nil, RetryPolicy{MaxRetries: 3, BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second}, nil)
+	var delays []time.Duration
+	limiter.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+	client := &http.Client{Transport: limiter}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("요청 실패: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || atomic.LoadInt32(&calls) != 3 {
+		t.Fatalf("상태 코드 = %d, 호출 수 = %d, want 200, 3", resp.StatusCode, atomic.LoadInt32(&calls))
+	}
+	// 지터 범위: [5ms, 10ms], [10ms, 20ms]
+	if len(delays) != 2 || delays[0] < 5*time.Millisecond || delays[0] > 10*time.Millisecond ||
+		delays[1] < 10*time.Millisecond || delays[1] > 20*time.Millisecond {
+		t.Errorf("재시도 대기 시간 = %v", delays)
+	}
+
+	// 본문이 있는 POST 요청은 재시도하지 않음
+	atomic.StoreInt32(&calls, 0)
+	resp, err = client.Post(server.URL, "text/plain", strings.NewReader("body"))
+	if err != nil {
+		t.Fatalf("POST 요청 실패: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadGateway || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("POST 상태 코드 = %d, 호출 수 = %d, want 502, 1", resp.StatusCode, atomic.LoadInt32(&calls))
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"3", 3 * time.Second, true},
+		{"Sat, 01 Mar 2025 12:00:30 GMT", 30 * time.Second, true},
+		{"Sat, 01 Mar 2025 11:59:00 GMT", 0, true},
+		{"", 0, false},
+		{"-1", 0, false},
+		{"soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("parseRetryAfter(%q) = (%v, %v), want (%v, %v)", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
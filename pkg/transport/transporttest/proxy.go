@@ -0,0 +1,80 @@
+// Package transporttest는 외부 요청 경로 테스트에 사용하는 가짜 워커 프록시를 제공합니다
+package transporttest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+)
+
+// ProxyHeader는 가짜 워커 프록시가 대상 서버로 보내는 요청에 추가하는 헤더입니다
+// 대상 서버는 이 헤더로 프록시를 거친 요청인지 구분할 수 있습니다
+const ProxyHeader = "X-Fake-Worker"
+
+// FakeWorker는 "워커주소?url=대상주소" 요청을 대상 주소로 전달하는 로컬 워커 프록시입니다
+type FakeWorker struct {
+	*httptest.Server
+	requests atomic.Int32
+	failing  atomic.Bool
+}
+
+// NewFakeWorker는 가짜 워커 프록시를 시작합니다 (사용 후 Close 호출)
+func NewFakeWorker() *FakeWorker {
+	worker := &FakeWorker{}
+	worker.Server = httptest.NewServer(http.HandlerFunc(worker.serve))
+	return worker
+}
+
+// WorkerErrorHeader는 가짜 워커 프록시가 대상 서버 응답이 아닌 자체 오류 응답에 붙이는 헤더입니다
+const WorkerErrorHeader = "X-Worker-Error"
+
+// SetFailing은 워커가 대상 주소로 전달하지 않고 502로 응답할지 설정합니다
+func (w *FakeWorker) SetFailing(failing bool) {
+	w.failing.Store(failing)
+}
+
+// Requests는 워커가 받은 요청 수를 반환합니다
+func (w *FakeWorker) Requests() int {
+	return int(w.requests.Load())
+}
+
+// serve는 url 쿼리 파라미터의 대상 주소로 요청을 전달하고 응답을 그대로 돌려줍니다
+func (w *FakeWorker) serve(rw http.ResponseWriter, r *http.Request) {
+	w.requests.Add(1)
+	if w.failing.Load() {
+		rw.Header().Set(WorkerErrorHeader, "1")
+		http.Error(rw, "worker unavailable", http.StatusBadGateway)
+		return
+	}
+
+	target := r.URL.Query().Get("url")
+	if target == "" {
+		http.Error(rw, "url parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, target, nil)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.Header = r.Header.Clone()
+	req.Header.Set(ProxyHeader, "1")
+
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		rw.Header().Set(WorkerErrorHeader, "1")
+		http.Error(rw, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	for key, values := range resp.Header {
+		for _, value := range values {
+			rw.Header().Add(key, value)
+		}
+	}
+	rw.WriteHeader(resp.StatusCode)
+	io.Copy(rw, resp.Body)
+}
@@ -8,6 +8,39 @@ var STICKER_DOMAINS = []string{
 	"post-phinf.pstatic.net",
 }
 
+// 네이버 포스트 본문 이미지 호스트
+// 스티커 도메인과 같지만 스마트에디터 ONE 이미지 컴포넌트에 있으면 스티커가 아닌 이미지로 처리
+const NAVER_POST_IMAGE_DOMAIN = "post-phinf.pstatic.net"
+
+// 티스토리 이모티콘 이미지 패턴
+var TISTORY_STICKER_PATTERNS = []string{
+	"keditor/emoticon",
+	"tistory_admin/assets/emoticon",
+	"tistory_admin/static/emoticon",
+}
+
+// 티스토리 본문 영역 선택자 (안쪽 영역부터 확인)
+var TISTORY_CONTENT_SELECTORS = []string{
+	".tt_article_useless_p_margin", // 티스토리 에디터 본문
+	".entry-content",               // 북클럽, 포스터 등 기본 스킨
+	".article-view",                // 오디세이, 매거진 스킨
+	"#article-view",                // 구버전 스킨
+	".contents_style",              // 구버전 스킨
+}
+
+// 범용 추출기에서 스티커(이모티콘)로 판단하는 이미지 키워드 (URL, class, alt)
+var GENERIC_STICKER_KEYWORDS = []string{
+	"emoji",
+	"emoticon",
+	"sticker",
+}
+
+// 범용 추출기에서 본문이 아닐 가능성이 높은 요소의 class/id 패턴
+const GENERIC_UNLIKELY_PATTERN = `(?i)comment|sidebar|footer|header|menu|nav|widget|share|related|banner|advert|popup|gnb|lnb|breadcrumb|subscribe|profile|recommend`
+
+// 범용 추출기에서 본문일 가능성이 높은 요소의 class/id 패턴
+const GENERIC_POSITIVE_PATTERN = `(?i)article|content|entry|post|body|main|text|story|wrap_body`
+
 // 협찬 업체 도메인 패턴
 var SPONSOR_DOMAINS = []string{
 	"cometoplay.kr",
@@ -71,8 +104,196 @@ const (
 	OPTIMAL_HEIGHT      = 500  // 최적의 이미지 높이
 )
 
-// 크롤링 설정
+// 본문 끝 부분으로 보는 블록 범위 (마지막 블록에서 몇 블록 이내)
+const NEAR_END_BLOCKS = 2
+
+// 본문 추출기별 신뢰도 (분석 확률에 곱함)
+const (
+	EXTRACTOR_TRUST_PLATFORM = 1.0 // 플랫폼 전용 어댑터
+	EXTRACTOR_TRUST_GENERIC  = 0.7 // 범용 추출기
+)
+
+// 포스트 분석 결과 보관 시간과 최대 개수 (넘으면 오래 사용하지 않은 결과부터 삭제)
 const (
-	CRAWL_MAX_RETRIES = 3
-	CRAWL_RETRY_DELAY = 500 * time.Millisecond
+	ANALYSIS_CACHE_TTL         = 24 * time.Hour
+	ANALYSIS_CACHE_MAX_ENTRIES = 10000
 )
+
+// OCR 결과 보관 시간
+const (
+	OCR_CACHE_TTL          = 7 * 24 * time.Hour // 텍스트를 추출한 결과
+	OCR_NEGATIVE_CACHE_TTL = 6 * time.Hour      // 텍스트가 없거나 GIF인 결과
+)
+
+// bolt OCR 저장소에서 만료된 항목을 정리하는 최소 간격 (저장할 때 간격이 지났으면 정리)
+const OCR_BOLT_PURGE_INTERVAL = 10 * time.Minute
+
+// 낮은 우선순위 OCR 요청이 사용할 수 있는 대기열 비율 (나머지는 높은 우선순위 요청용으로 남겨 둠)
+const OCR_LOW_PRIORITY_QUEUE_SHARE = 0.5
+
+// Tesseract 실행 옵션
+const (
+	TESSERACT_PSM = "6" // 페이지 분할 모드 (균일한 텍스트 블록)
+	TESSERACT_OEM = "3" // 인식 엔진 모드 (기본값)
+)
+
+// 원격 OCR 서비스에 인식할 언어를 전달하는 헤더
+const OCR_REMOTE_LANGUAGES_HEADER = "X-OCR-Languages"
+
+// 원격 OCR 서비스 주소를 바꾸는 워커 경로 이름 (NGROK_WORKER_TUNNELS에 "ocr=터널"로 지정하면 터널 주소 사용)
+const OCR_REMOTE_ROUTE_NAME = "ocr"
+
+// Redis OCR 캐시 키 접두사
+const OCR_CACHE_REDIS_KEY_PREFIX = "ndns:ocr:"
+
+// 이미지 내용(SHA-256)으로 OCR 결과를 찾는 캐시 키 접두사
+const OCR_CONTENT_HASH_KEY_PREFIX = "sha256:"
+
+// 이미지 크기/형태만 바꾸는 쿼리 파라미터 (예: ?type=w773, ?type=w80_blur)
+// OCR 캐시 키를 만들 때 제거하여 같은 이미지를 가리키는 URL을 하나로 모음
+// 다른 호스트에서는 같은 이름의 파라미터가 다른 이미지를 가리킬 수 있으므로 IMAGE_SIZE_QUERY_HOST_SUFFIX 호스트에서만 제거
+var IMAGE_SIZE_QUERY_PARAMS = []string{"type"}
+
+// 크기/형태 파라미터를 제거하는 이미지 호스트 (네이버 이미지 서버)
+const IMAGE_SIZE_QUERY_HOST_SUFFIX = ".pstatic.net"
+
+// 같은 경로로 같은 파일을 제공하는 이미지 호스트 → 대표 호스트
+var IMAGE_MIRROR_HOSTS = map[string]string{
+	"blogfiles.pstatic.net":        "postfiles.pstatic.net",
+	"mblogthumb-phinf.pstatic.net": "postfiles.pstatic.net",
+}
+
+// 외부 요청 재시도 및 대기 설정
+const (
+	HTTP_RETRY_BASE_DELAY = 500 * time.Millisecond // 첫 재시도 대기 시간 (재시도마다 2배씩 증가)
+	HTTP_RETRY_MAX_DELAY  = 10 * time.Second       // 재시도 대기 시간 상한 (Retry-After가 더 길면 재시도하지 않음)
+	HTTP_MAX_QUEUE_WAIT   = 30 * time.Second       // 호스트 요청 제한으로 대기할 수 있는 최대 시간
+)
+
+// 요청 제한 규칙에 해당하지 않는 호스트의 기본 제한
+const (
+	HTTP_DEFAULT_HOST_RATE          = 10.0 // 초당 요청 수
+	HTTP_DEFAULT_HOST_BURST         = 10   // 연속으로 보낼 수 있는 요청 수
+	HTTP_DEFAULT_HOST_MAX_IN_FLIGHT = 8    // 동시에 처리 중인 최대 요청 수
+)
+
+// 외부 요청 경로(직접, 워커 프록시)의 상태 관리 설정
+const (
+	EGRESS_HEALTH_WEIGHT      = 0.3              // 성공률 지수 이동 평균에서 최근 요청의 가중치
+	EGRESS_MIN_HEALTH         = 0.5              // 성공률이 이보다 낮아지면 경로를 잠시 사용하지 않음
+	EGRESS_ROUTE_COOLDOWN     = 30 * time.Second // 경로를 사용하지 않는 시간 (연속으로 낮아지면 2배씩 증가)
+	EGRESS_ROUTE_MAX_COOLDOWN = 5 * time.Minute
+)
+
+// 워커 프록시로 보내지 않는 인증 헤더 (이 헤더가 있는 요청은 직접 요청 경로만 사용)
+var EGRESS_DIRECT_ONLY_HEADERS = []string{"Authorization", "X-Naver-Client-Id", "X-Naver-Client-Secret"}
+
+// 다른 경로로 다시 보내는 응답 상태 코드
+// 대상 서버의 응답이면 경로 성공률에 반영하지 않고, 워커 프록시 자체의 오류 응답이면 경로 실패로 기록
+var EGRESS_FAILOVER_STATUS_CODES = []int{403, 429, 502, 503, 504}
+
+// 워커 프록시가 대상 서버 응답이 아닌 자체 오류 응답에 붙이는 헤더 (ngrok 터널 오류 포함)
+var EGRESS_WORKER_ERROR_HEADERS = []string{"X-Worker-Error", "Ngrok-Error-Code"}
+
+// 호스트 차단 감지 후 요청을 멈추는 시간 (연속 차단 시 2배씩 증가)
+const (
+	BLOCK_COOLDOWN     = 5 * time.Minute
+	BLOCK_MAX_COOLDOWN = 30 * time.Minute
+)
+
+// 차단으로 판단하는 응답 상태 코드
+// 403은 비공개, 삭제된 포스트처럼 주소 하나에 대한 응답인 경우가 많아 따로 횟수를 세어 판단
+var BLOCK_STATUS_CODES = []int{429}
+
+// 같은 호스트에서 403 응답이 반복되면 차단으로 판단하는 기준
+const (
+	BLOCK_FORBIDDEN_THRESHOLD = 5           // 차단으로 판단하는 403 응답 횟수
+	BLOCK_FORBIDDEN_WINDOW    = time.Minute // 403 응답 횟수를 세는 시간
+)
+
+// 캡차 페이지 표식 (HTML 원문에서 검색)
+var CAPTCHA_PAGE_MARKERS = []string{
+	"captcha.naver.com",
+	"ncaptcha",
+	"g-recaptcha",
+	"자동입력 방지문자",
+	"자동입력방지",
+}
+
+// 접근 제한 안내 페이지 표식 (본문 텍스트에서 검색)
+var BLOCK_PAGE_MARKERS = []string{
+	"비정상적인 접근",
+	"일시적으로 제한",
+	"서비스 이용이 제한",
+	"접근이 차단",
+	"과도한 요청",
+	"Too Many Requests",
+	"Access Denied",
+}
+
+// 차단 안내 페이지로 볼 수 있는 최대 본문 길이 (이보다 긴 페이지는 일반 포스트로 간주)
+const BLOCK_PAGE_MAX_TEXT_LENGTH = 1000
+
+// 네이버 블로그 댓글 목록 API
+const NAVER_COMMENT_API_URL = "https://apis.naver.com/commentBox/cbox/web_naver_list_jsonp.json"
+
+// 댓글 수집 범위
+const (
+	COMMENT_PAGE_SIZE = 50 // 한 번에 가져오는 댓글 수
+	COMMENT_MAX_PAGES = 3  // 포스트마다 가져오는 최대 페이지 수
+)
+
+// 댓글 작성자별 신뢰도 (분석 확률에 곱함)
+const (
+	COMMENT_TRUST_OWNER  = 1.0 // 블로그 주인의 댓글, 답글
+	COMMENT_TRUST_READER = 0.6 // 독자의 추측
+)
+
+// 독자 댓글만으로 줄 수 있는 최대 협찬 확률 (협찬 판단 기준보다 낮게 두어 추측만으로는 협찬으로 판단하지 않음)
+const COMMENT_READER_MAX_PROBABILITY = 0.6
+
+// 협찬을 부정하는 댓글 표식 (예: "협찬 아니에요, 내돈내산")
+var COMMENT_NEGATION_MARKERS = []string{
+	"아니에요", "아니예요", "아닙니다", "아니구요", "아니고요", "아님",
+	"안 받", "안받", "받지 않", "받은 거 아",
+	"내돈내산", "내 돈 내산", "내돈 내산", "사비로", "자비로", "제 돈",
+}
+
+// 질문 댓글의 끝맺음 (물음표 없이 끝나는 질문, 끝의 문장부호와 웃음 표시는 제외하고 확인)
+var COMMENT_QUESTION_ENDINGS = []string{"가요", "나요", "까요", "는지요", "인지요", "건지", "인가", "인지", "냐"}
+
+// 볼 수 없는 포스트 안내 페이지 표식 (안내 영역 텍스트와 알림창 스크립트 문구에서 검색)
+var (
+	DELETED_POST_MARKERS = []string{
+		"삭제되었거나 존재하지 않는",
+		"존재하지 않는 게시물",
+		"삭제된 게시물",
+		"삭제된 글입니다",
+		"요청하신 페이지를 찾을 수 없습니다",
+	}
+	PRIVATE_POST_MARKERS = []string{
+		"비공개 글입니다",
+		"비공개 포스트",
+		"비공개로 설정",
+		"작성자만 볼 수 있는",
+	}
+	NEIGHBORS_ONLY_POST_MARKERS = []string{
+		"이웃공개 글입니다",
+		"서로이웃 공개",
+		"서로이웃만",
+		"이웃에게만 공개",
+		"이웃만 볼 수 있는",
+	}
+	ADULT_POST_MARKERS = []string{
+		"성인인증이 필요",
+		"성인 인증이 필요",
+		"19세 미만의 청소년",
+		"청소년에게 유해한 정보",
+	}
+)
+
+// 볼 수 없는 포스트 안내 페이지로 볼 수 있는 최대 본문 길이 (이보다 긴 페이지는 일반 포스트로 간주)
+const UNAVAILABLE_PAGE_MAX_TEXT_LENGTH = 1500
+
+// 볼 수 없는 포스트 안내 문구가 표시되는 영역 (본문, 숨겨진 레이어의 문구는 확인하지 않음)
+const UNAVAILABLE_NOTICE_SELECTOR = ".error_content, .adult_content, .error_area, .error_page, #error_content"
@@ -5,4 +5,7 @@ type SearchQuery struct {
 	Query  string `json:"query" validate:"required,min=2,max=100"`
 	Limit  int    `json:"limit,omitempty" validate:"min=1,max=100"`
 	Offset int    `json:"offset,omitempty" validate:"min=0"`
+	Source string `json:"source,omitempty" validate:"regexp=^(blog|cafe)$"`
+	// ExcludeUnavailable이 true이면 삭제, 비공개, 이웃공개, 성인 인증 포스트를 결과에서 제외합니다
+	ExcludeUnavailable bool `json:"excludeUnavailable,omitempty"`
 }
@@ -9,4 +9,16 @@ type HealthResponse struct {
 	Version   string    `json:"version"`
 	Uptime    string    `json:"uptime"`
 	GoVersion string    `json:"goVersion"`
+	// 외부 호스트(네이버 등)가 크롤링을 차단하여 요청을 중지한 상태인지 여부
+	Blocked      bool          `json:"blocked"`
+	BlockedHosts []BlockedHost `json:"blockedHosts,omitempty"`
+}
+
+// BlockedHost는 차단이 감지되어 요청을 중지한 호스트 정보를 나타냅니다.
+type BlockedHost struct {
+	Host    string    `json:"host"`
+	Reason  string    `json:"reason"`
+	Since   time.Time `json:"since"`
+	Until   time.Time `json:"until"`
+	Strikes int       `json:"strikes"`
 }
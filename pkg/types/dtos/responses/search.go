@@ -5,6 +5,7 @@ import structure "github.com/sh5080/ndns-go/pkg/types/structures"
 // SearchResponse는 검색 요청에 대한 응답을 나타냅니다.
 type Search struct {
 	Keyword          string               `json:"keyword"`
+	Source           string               `json:"source"`
 	TotalResults     int                  `json:"totalResults"`
 	SponsoredResults int                  `json:"sponsoredResults"`
 	Page             int                  `json:"page"`
@@ -12,6 +12,8 @@ type OCRCache struct {
 	TextDetected string              `json:"textDetected"` // OCR 결과 텍스트
 	ImageType    structure.BlogImage `json:"imageType"`    // 이미지 타입
 	Result       string              `json:"result"`       // OCR 결과
+	ErrorCode    string              `json:"errorCode"`    // 텍스트를 추출하지 못한 원인 (부정 캐시)
+	Engine       string              `json:"engine"`       // 결과를 만든 OCR 엔진
 	CreatedAt    time.Time           `json:"createdAt"`    // 생성 시간
 	ExpiresAt    time.Time           `json:"expiresAt"`    // 만료 시간
 }
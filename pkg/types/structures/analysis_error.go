@@ -0,0 +1,97 @@
+package structure
+
+import "errors"
+
+// ErrorCode는 포스트 분석 실패 원인을 구분하는 코드입니다
+type ErrorCode string
+
+const (
+	ErrorCodeDeletedPost         ErrorCode = "deleted_post"         // 삭제되었거나 존재하지 않는 포스트
+	ErrorCodePrivatePost         ErrorCode = "private_post"         // 비공개 포스트
+	ErrorCodeNeighborsOnly       ErrorCode = "neighbors_only"       // 이웃/서로이웃 공개 포스트
+	ErrorCodeAdultOnly           ErrorCode = "adult_only"           // 성인 인증이 필요한 포스트
+	ErrorCodeUnsupportedPlatform ErrorCode = "unsupported_platform" // 분석할 수 없는 플랫폼 또는 페이지 형식
+	ErrorCodeTimeout             ErrorCode = "timeout"              // 요청 또는 OCR 처리 시간 초과
+	ErrorCodeBlocked             ErrorCode = "blocked"              // 대상 호스트가 요청을 차단함 (캡차, 접근 제한 등)
+	ErrorCodeImageTooLarge       ErrorCode = "image_too_large"      // OCR 크기 제한을 넘는 이미지
+	ErrorCodeGIFUnsupported      ErrorCode = "gif_unsupported"      // OCR을 지원하지 않는 GIF 이미지
+	ErrorCodeOCREmpty            ErrorCode = "ocr_empty"            // 이미지에서 텍스트를 찾지 못함
+	ErrorCodeOCRBusy             ErrorCode = "ocr_busy"             // OCR 대기열이 가득 차 이미지를 분석하지 못함
+	ErrorCodeCrawlFailed         ErrorCode = "crawl_failed"         // 그 밖의 크롤링 실패
+	ErrorCodeOCRFailed           ErrorCode = "ocr_failed"           // 그 밖의 OCR 실패
+)
+
+// AnalysisStatus는 포스트 분석을 어디까지 마쳤는지 나타냅니다
+// 협찬이 아닌 포스트와 확인하지 못한 포스트를 구분하는 데 사용합니다
+type AnalysisStatus string
+
+const (
+	AnalysisStatusComplete    AnalysisStatus = "complete"    // 모든 단계를 분석했거나 협찬 근거를 찾음
+	AnalysisStatusPartial     AnalysisStatus = "partial"     // 본문은 분석했지만 일부 단계(OCR 등)가 실패함
+	AnalysisStatusFailed      AnalysisStatus = "failed"      // 본문을 가져오지 못해 분석하지 못함
+	AnalysisStatusUnavailable AnalysisStatus = "unavailable" // 삭제, 비공개 등으로 본문을 볼 수 없는 포스트
+)
+
+// IsUnavailable은 포스트 본문을 볼 수 없는 상태(삭제, 비공개, 이웃공개, 성인 인증)를 나타내는 코드인지 확인합니다
+func (c ErrorCode) IsUnavailable() bool {
+	switch c {
+	case ErrorCodeDeletedPost, ErrorCodePrivatePost, ErrorCodeNeighborsOnly, ErrorCodeAdultOnly:
+		return true
+	}
+	return false
+}
+
+// AnalysisError는 실패 원인 코드를 가진 크롤링/OCR 에러입니다
+type AnalysisError struct {
+	Code    ErrorCode
+	Message string
+	Err     error // 원인 에러 (없으면 nil)
+}
+
+// 코드로 비교하는 기준 에러 (errors.Is(err, ErrOCREmpty) 형태로 사용)
+var (
+	ErrDeletedPost         = &AnalysisError{Code: ErrorCodeDeletedPost, Message: "삭제되었거나 존재하지 않는 포스트"}
+	ErrPrivatePost         = &AnalysisError{Code: ErrorCodePrivatePost, Message: "비공개 포스트"}
+	ErrNeighborsOnly       = &AnalysisError{Code: ErrorCodeNeighborsOnly, Message: "이웃공개 포스트"}
+	ErrAdultOnly           = &AnalysisError{Code: ErrorCodeAdultOnly, Message: "성인 인증이 필요한 포스트"}
+	ErrUnsupportedPlatform = &AnalysisError{Code: ErrorCodeUnsupportedPlatform, Message: "지원하지 않는 플랫폼"}
+	ErrTimeout             = &AnalysisError{Code: ErrorCodeTimeout, Message: "시간 초과"}
+	ErrImageTooLarge       = &AnalysisError{Code: ErrorCodeImageTooLarge, Message: "이미지 크기가 너무 큼"}
+	ErrGIFUnsupported      = &AnalysisError{Code: ErrorCodeGIFUnsupported, Message: "GIF 파일은 OCR 미지원"}
+	ErrOCREmpty            = &AnalysisError{Code: ErrorCodeOCREmpty, Message: "OCR 인식 불가"}
+	ErrOCRBusy             = &AnalysisError{Code: ErrorCodeOCRBusy, Message: "OCR 대기열 포화"}
+)
+
+// NewAnalysisError는 원인 코드와 메시지를 가진 에러를 생성합니다
+func NewAnalysisError(code ErrorCode, message string, err error) *AnalysisError {
+	return &AnalysisError{Code: code, Message: message, Err: err}
+}
+
+// Error는 에러 메시지를 반환합니다
+func (e *AnalysisError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap은 원인 에러를 반환합니다
+func (e *AnalysisError) Unwrap() error {
+	return e.Err
+}
+
+// Is는 원인 코드가 같은 AnalysisError를 같은 에러로 봅니다
+func (e *AnalysisError) Is(target error) bool {
+	other, ok := target.(*AnalysisError)
+	return ok && other.Code == e.Code
+}
+
+// ErrorCodeOf는 에러 체인에서 AnalysisError의 원인 코드를 찾습니다
+// AnalysisError가 없으면 빈 값을 반환합니다
+func ErrorCodeOf(err error) ErrorCode {
+	var analysisErr *AnalysisError
+	if errors.As(err, &analysisErr) {
+		return analysisErr.Code
+	}
+	return ""
+}
@@ -1,5 +1,15 @@
 package structure
 
+import "time"
+
+// SearchSource는 네이버 검색 API의 검색 대상을 정의합니다
+type SearchSource string
+
+const (
+	SearchSourceBlog SearchSource = "blog" // 블로그 검색
+	SearchSourceCafe SearchSource = "cafe" // 카페글 검색
+)
+
 type NaverSearchItem struct {
 	Title       string `json:"title"`
 	Link        string `json:"link"`
@@ -7,6 +17,8 @@ type NaverSearchItem struct {
 	BloggerName string `json:"bloggerName"`
 	BloggerLink string `json:"bloggerLink"`
 	PostDate    string `json:"postDate"`
+	CafeName    string `json:"cafeName,omitempty"`
+	CafeURL     string `json:"cafeUrl,omitempty"`
 }
 
 type BlogImage string
@@ -26,14 +38,37 @@ type NaverSearchResponse struct {
 
 type BlogPost struct {
 	NaverSearchItem
+	PostID             *PostID            `json:"postId,omitempty"`
+	CanonicalURL       string             `json:"canonicalUrl,omitempty"`
 	IsSponsored        bool               `json:"isSponsored"`
 	SponsorProbability float64            `json:"sponsorProbability"`
 	SponsorIndicators  []SponsorIndicator `json:"sponsorIndicators"`
+	Extractor          string             `json:"extractor,omitempty"`
 	Error              string             `json:"error,omitempty"`
+	AnalysisStatus     AnalysisStatus     `json:"analysisStatus"`
+	ErrorCodes         []ErrorCode        `json:"errorCodes,omitempty"`
+	Metadata           *PostMetadata      `json:"metadata,omitempty"`
 }
 
+// EditorVersion은 네이버 포스트를 작성한 에디터 세대를 정의합니다
+type EditorVersion string
+
+const (
+	EditorSmartEditorOne EditorVersion = "smarteditor_one" // 스마트에디터 ONE (.se-main-container)
+	EditorSmartEditor2   EditorVersion = "smarteditor_2"   // 스마트에디터 2.0 (.se_component_wrap)
+	EditorSmartEditor1   EditorVersion = "smarteditor_1"   // 스마트에디터 1.0 (#postViewArea)
+	EditorMobile         EditorVersion = "mobile"          // 구버전 모바일 (.post_ct)
+	EditorUnknown        EditorVersion = "unknown"         // 판별 불가
+)
+
+// ExtractorGeneric은 범용 본문 추출기 이름입니다
+const ExtractorGeneric = "generic"
+
 type CrawlResult struct {
 	URL              string
+	Extractor        string        // 본문을 추출한 어댑터 이름
+	Trust            float64       // 추출 결과 신뢰도 (0~1)
+	EditorVersion    EditorVersion // 네이버 포스트 에디터 세대 (네이버 외 플랫폼은 빈 값)
 	FirstParagraph   string
 	LastParagraph    string
 	Content          string
@@ -42,4 +77,33 @@ type CrawlResult struct {
 	FirstStickerURL  string
 	SecondStickerURL string
 	LastStickerURL   string
+	Blocks           []ContentBlock // 본문 블록 (순서대로)
+	Metadata         PostMetadata   // 카테고리, 발행 시각, 댓글/공감 수 등 포스트 부가 정보
+	Comments         []PostComment  // 댓글 목록 (댓글을 가져올 수 있는 플랫폼만)
+}
+
+// PostComment는 포스트에 달린 댓글 하나입니다
+type PostComment struct {
+	Author  string `json:"author"`
+	Text    string `json:"text"`
+	IsOwner bool   `json:"isOwner"` // 블로그 주인이 작성한 댓글
+	IsReply bool   `json:"isReply"` // 다른 댓글에 단 답글
+}
+
+// PostMetadata는 본문 외에 포스트 페이지에서 얻을 수 있는 부가 정보입니다
+// 페이지에서 찾지 못한 값은 빈 값으로 둡니다
+type PostMetadata struct {
+	Category      string     `json:"category,omitempty"`    // 블로그 카테고리 이름
+	Tags          []string   `json:"tags,omitempty"`        // 태그 (# 없이, 표시 순서)
+	PublishedAt   *time.Time `json:"publishedAt,omitempty"` // 발행 시각 (분 단위, KST)
+	ModifiedAt    *time.Time `json:"modifiedAt,omitempty"`  // 마지막 수정 시각 (분 단위, KST, 수정하지 않았으면 nil)
+	CommentCount  int        `json:"commentCount"`          // 댓글 수
+	SympathyCount int        `json:"sympathyCount"`         // 공감 수
+	InSeries      bool       `json:"inSeries"`              // 시리즈(연재) 포스트 여부
+}
+
+// IsEmpty는 부가 정보를 하나도 찾지 못했는지 확인합니다
+func (m PostMetadata) IsEmpty() bool {
+	return m.Category == "" && len(m.Tags) == 0 && m.PublishedAt == nil && m.ModifiedAt == nil &&
+		m.CommentCount == 0 && m.SympathyCount == 0 && !m.InSeries
 }
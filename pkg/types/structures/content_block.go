@@ -0,0 +1,71 @@
+package structure
+
+// BlockType은 본문 블록의 종류를 정의합니다
+type BlockType string
+
+const (
+	BlockTypeText     BlockType = "text"      // 일반 문단
+	BlockTypeQuote    BlockType = "quote"     // 인용구
+	BlockTypeImage    BlockType = "image"     // 이미지 (캡션 포함)
+	BlockTypeSticker  BlockType = "sticker"   // 스티커, 이모티콘
+	BlockTypeLinkCard BlockType = "link_card" // 링크 미리보기 카드
+	BlockTypeMap      BlockType = "map"       // 지도, 장소 정보
+	BlockTypeVideo    BlockType = "video"     // 동영상
+	BlockTypeHR       BlockType = "hr"        // 구분선
+)
+
+// ContentBlock은 본문을 구성하는 하나의 블록입니다
+type ContentBlock struct {
+	Index    int       `json:"index"`              // 본문 내 순서 (0부터 시작)
+	Type     BlockType `json:"type"`               // 블록 종류
+	Text     string    `json:"text,omitempty"`     // 문단, 인용구, 카드/지도/동영상 제목
+	ImageURL string    `json:"imageUrl,omitempty"` // 이미지, 스티커, 카드 썸네일 URL
+	Caption  string    `json:"caption,omitempty"`  // 이미지 캡션
+	LinkURL  string    `json:"linkUrl,omitempty"`  // 링크 카드, 지도, 동영상 URL
+	Position float64   `json:"position"`           // 본문 내 상대 위치 (0: 처음, 1: 끝)
+}
+
+// FirstBlocks는 본문의 처음 n개 블록을 반환합니다
+func (r *CrawlResult) FirstBlocks(n int) []ContentBlock {
+	if n > len(r.Blocks) {
+		n = len(r.Blocks)
+	}
+	if n <= 0 {
+		return nil
+	}
+	return r.Blocks[:n]
+}
+
+// LastBlocks는 본문의 마지막 n개 블록을 반환합니다
+func (r *CrawlResult) LastBlocks(n int) []ContentBlock {
+	if n > len(r.Blocks) {
+		n = len(r.Blocks)
+	}
+	if n <= 0 {
+		return nil
+	}
+	return r.Blocks[len(r.Blocks)-n:]
+}
+
+// BlocksOfType은 지정한 종류의 블록을 순서대로 반환합니다
+func (r *CrawlResult) BlocksOfType(blockType BlockType) []ContentBlock {
+	var blocks []ContentBlock
+	for _, block := range r.Blocks {
+		if block.Type == blockType {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// FindNearEnd는 마지막 블록에서 within개 이내에 있는 지정한 종류의 블록 중 가장 끝에 가까운 블록을 반환합니다
+// within이 0이면 마지막 블록만 확인합니다
+func (r *CrawlResult) FindNearEnd(blockType BlockType, within int) *ContentBlock {
+	for i := len(r.Blocks) - 1; i >= 0 && i >= len(r.Blocks)-1-within; i-- {
+		if r.Blocks[i].Type == blockType {
+			block := r.Blocks[i]
+			return &block
+		}
+	}
+	return nil
+}
@@ -1,11 +1,36 @@
 package structure
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // OCRCache는 OCR 결과를 캐싱하기 위한 구조체입니다
+// 텍스트가 없거나 GIF인 이미지는 ErrorCode를 기록하여 다시 OCR하지 않도록 합니다 (부정 캐시)
 type OCRCache struct {
-	ImageURL     string    `json:"imageURL"`
+	ImageURL     string    `json:"imageURL"` // 캐시 키: 정규화한 이미지 URL 또는 "sha256:<이미지 내용 해시>"
 	TextDetected string    `json:"textDetected"`
 	ImageType    string    `json:"imageType"`
+	ErrorCode    ErrorCode `json:"errorCode,omitempty"`
+	Engine       string    `json:"engine,omitempty"` // 결과를 만든 OCR 엔진 (tesseract, remote, fake)
 	DetectedAt   time.Time `json:"detectedAt"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+// IsNegative는 텍스트를 추출하지 못한 결과를 저장한 캐시인지 확인합니다
+func (c OCRCache) IsNegative() bool {
+	return c.ErrorCode != ""
+}
+
+// IsExpired는 보관 시간이 지난 캐시인지 확인합니다
+func (c OCRCache) IsExpired(now time.Time) bool {
+	return !c.ExpiresAt.IsZero() && !now.Before(c.ExpiresAt)
+}
+
+// Err는 부정 캐시를 OCR 실패 때와 같은 에러로 반환합니다 (텍스트가 있으면 nil)
+func (c OCRCache) Err() error {
+	if !c.IsNegative() {
+		return nil
+	}
+	return NewAnalysisError(c.ErrorCode, fmt.Sprintf("캐시된 OCR 결과: %s (%s)", c.ErrorCode, c.ImageURL), nil)
 }
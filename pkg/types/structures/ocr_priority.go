@@ -0,0 +1,18 @@
+package structure
+
+// OCRPriority는 OCR 대기열에서 이미지를 처리하는 순서입니다
+// 대기열이 붐비면 낮은 우선순위 요청부터 거절합니다
+type OCRPriority int
+
+const (
+	OCRPriorityHigh OCRPriority = iota // 첫 이미지/스티커 (협찬 문구가 주로 있는 위치)
+	OCRPriorityLow                     // 마지막 이미지/스티커
+)
+
+// String은 메트릭 라벨로 사용하는 우선순위 이름을 반환합니다
+func (p OCRPriority) String() string {
+	if p == OCRPriorityHigh {
+		return "high"
+	}
+	return "low"
+}
@@ -0,0 +1,214 @@
+package structure
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Platform은 포스트가 게시된 플랫폼을 정의합니다
+type Platform string
+
+const (
+	PlatformNaverBlog Platform = "naver_blog" // 네이버 블로그
+	PlatformNaverCafe Platform = "naver_cafe" // 네이버 카페
+	PlatformNaverPost Platform = "naver_post" // 네이버 포스트
+	PlatformTistory   Platform = "tistory"    // 티스토리
+	PlatformWeb       Platform = "web"        // 그 외 웹 페이지
+)
+
+// 글 번호 형식
+var postNoRegex = regexp.MustCompile(`^[0-9]+$`)
+
+// PostID는 URL 형식과 관계없이 하나의 포스트를 식별하는 값입니다
+type PostID struct {
+	Platform Platform `json:"platform"`
+	Owner    string   `json:"owner"`
+	PostNo   string   `json:"postNo"`
+}
+
+// ParsePostID는 포스트 URL에서 PostID를 추출합니다
+// 같은 포스트의 PC/모바일/PostView URL은 모두 같은 PostID가 됩니다
+func ParsePostID(rawURL string) (PostID, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return PostID{}, fmt.Errorf("URL이 비어 있습니다")
+	}
+	if !strings.HasPrefix(rawURL, "http://") && !strings.HasPrefix(rawURL, "https://") {
+		rawURL = "https://" + rawURL
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return PostID{}, fmt.Errorf("URL 파싱 실패: %v", err)
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host == "" {
+		return PostID{}, fmt.Errorf("URL에 호스트가 없습니다: %s", rawURL)
+	}
+
+	query := parsed.Query()
+	segments := strings.FieldsFunc(parsed.Path, func(r rune) bool { return r == '/' })
+
+	var id PostID
+	switch {
+	case host == "blog.naver.com" || host == "m.blog.naver.com":
+		id = parseNaverBlogPostID(query, segments)
+	case strings.HasSuffix(host, ".blog.me"):
+		// 구버전 개인 도메인: {blogId}.blog.me/{logNo}
+		id = PostID{Platform: PlatformNaverBlog, Owner: strings.TrimSuffix(host, ".blog.me")}
+		if len(segments) > 0 && postNoRegex.MatchString(segments[0]) {
+			id.PostNo = segments[0]
+		}
+	case host == "cafe.naver.com" || host == "m.cafe.naver.com":
+		id = parseNaverCafePostID(query, segments)
+	case host == "post.naver.com" || host == "m.post.naver.com":
+		id = PostID{Platform: PlatformNaverPost, Owner: query.Get("memberNo"), PostNo: query.Get("volumeNo")}
+	case strings.HasSuffix(host, ".tistory.com") && host != "www.tistory.com":
+		id = parseTistoryPostID(strings.TrimSuffix(host, ".tistory.com"), segments)
+	default:
+		return parseWebPostID(host, parsed), nil
+	}
+
+	if id.Owner == "" || id.PostNo == "" {
+		return PostID{}, fmt.Errorf("포스트 식별 정보를 찾을 수 없습니다: %s", rawURL)
+	}
+	if id.Platform == PlatformNaverBlog || id.Platform == PlatformTistory {
+		id.Owner = strings.ToLower(id.Owner)
+	}
+	return id, nil
+}
+
+// parseNaverBlogPostID는 네이버 블로그 URL 경로와 쿼리에서 blogId와 logNo를 추출합니다
+func parseNaverBlogPostID(query url.Values, segments []string) PostID {
+	// 쿼리 파라미터 형식 (PostView, PostList, Redirect=Log)
+	blogID := query.Get("blogId")
+	logNo := query.Get("logNo")
+
+	if len(segments) > 0 && !strings.Contains(segments[0], ".") {
+		// 경로 형식: /{blogId}/{logNo} 또는 /{blogId}?logNo=
+		if blogID == "" {
+			blogID = segments[0]
+		}
+		if logNo == "" && len(segments) > 1 {
+			logNo = segments[1]
+		}
+	}
+
+	if !postNoRegex.MatchString(logNo) {
+		logNo = ""
+	}
+	return PostID{Platform: PlatformNaverBlog, Owner: blogID, PostNo: logNo}
+}
+
+// parseNaverCafePostID는 네이버 카페 URL에서 카페와 게시글 번호를 추출합니다
+func parseNaverCafePostID(query url.Values, segments []string) PostID {
+	id := PostID{Platform: PlatformNaverCafe}
+
+	// 프레임 URL에 게시글 주소가 포함된 경우
+	if iframeURL := query.Get("iframe_url"); iframeURL != "" {
+		if inner, err := url.Parse(iframeURL); err == nil {
+			query = inner.Query()
+			segments = strings.FieldsFunc(inner.Path, func(r rune) bool { return r == '/' })
+		}
+	}
+
+	switch {
+	case query.Get("clubid") != "" && query.Get("articleid") != "":
+		// ArticleRead.nhn?clubid=&articleid=
+		id.Owner = query.Get("clubid")
+		id.PostNo = query.Get("articleid")
+	case len(segments) >= 6 && segments[0] == "ca-fe" && segments[4] == "articles":
+		// 모바일: /ca-fe/web/cafes/{cafe}/articles/{articleId}
+		id.Owner = segments[3]
+		id.PostNo = segments[5]
+	case len(segments) >= 5 && segments[0] == "ca-fe" && segments[3] == "articles":
+		// /ca-fe/cafes/{cafe}/articles/{articleId}
+		id.Owner = segments[2]
+		id.PostNo = segments[4]
+	case len(segments) >= 2:
+		// /{cafe}/{articleId}
+		id.Owner = segments[0]
+		id.PostNo = segments[1]
+	}
+
+	if !postNoRegex.MatchString(id.PostNo) {
+		id.PostNo = ""
+	}
+	return id
+}
+
+// parseTistoryPostID는 티스토리 URL에서 글 번호 또는 슬러그를 추출합니다
+func parseTistoryPostID(owner string, segments []string) PostID {
+	// 모바일 경로(/m/) 제거
+	if len(segments) > 0 && segments[0] == "m" {
+		segments = segments[1:]
+	}
+
+	id := PostID{Platform: PlatformTistory, Owner: owner}
+	switch {
+	case len(segments) == 1 && postNoRegex.MatchString(segments[0]):
+		id.PostNo = segments[0]
+	case len(segments) == 2 && segments[0] == "entry":
+		id.PostNo = segments[1]
+	}
+	return id
+}
+
+// parseWebPostID는 지원하지 않는 플랫폼의 URL을 호스트와 경로로 식별합니다
+func parseWebPostID(host string, parsed *url.URL) PostID {
+	postNo := strings.TrimSuffix(parsed.EscapedPath(), "/")
+	if postNo == "" {
+		postNo = "/"
+	}
+	if parsed.RawQuery != "" {
+		// 쿼리 파라미터 순서를 정렬하여 같은 페이지를 같은 값으로 식별
+		postNo += "?" + parsed.Query().Encode()
+	}
+
+	return PostID{
+		Platform: PlatformWeb,
+		Owner:    strings.TrimPrefix(host, "www."),
+		PostNo:   postNo,
+	}
+}
+
+// IsZero는 PostID가 비어 있는지 확인합니다
+func (id PostID) IsZero() bool {
+	return id.Platform == "" && id.Owner == "" && id.PostNo == ""
+}
+
+// Key는 저장소 키로 사용할 PostID 문자열을 반환합니다
+func (id PostID) Key() string {
+	return fmt.Sprintf("%s:%s:%s", id.Platform, id.Owner, id.PostNo)
+}
+
+// String은 PostID를 문자열로 반환합니다
+func (id PostID) String() string {
+	return id.Key()
+}
+
+// CanonicalURL은 포스트의 대표 URL을 반환합니다
+func (id PostID) CanonicalURL() string {
+	switch id.Platform {
+	case PlatformNaverBlog:
+		return fmt.Sprintf("https://blog.naver.com/%s/%s", id.Owner, id.PostNo)
+	case PlatformNaverCafe:
+		if postNoRegex.MatchString(id.Owner) {
+			return fmt.Sprintf("https://cafe.naver.com/ca-fe/cafes/%s/articles/%s", id.Owner, id.PostNo)
+		}
+		return fmt.Sprintf("https://cafe.naver.com/%s/%s", id.Owner, id.PostNo)
+	case PlatformNaverPost:
+		return fmt.Sprintf("https://post.naver.com/viewer/postView.naver?volumeNo=%s&memberNo=%s", id.PostNo, id.Owner)
+	case PlatformTistory:
+		if postNoRegex.MatchString(id.PostNo) {
+			return fmt.Sprintf("https://%s.tistory.com/%s", id.Owner, id.PostNo)
+		}
+		return fmt.Sprintf("https://%s.tistory.com/entry/%s", id.Owner, id.PostNo)
+	case PlatformWeb:
+		return "https://" + id.Owner + id.PostNo
+	}
+	return ""
+}
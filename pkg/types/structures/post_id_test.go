@@ -0,0 +1,70 @@
+package structure
+
+import "testing"
+
+func TestParsePostID(t *testing.T) {
+	tests := []struct {
+		url       string
+		want      PostID
+		canonical string
+	}{
+		// 네이버 블로그: PC, 모바일, PostView, 구버전 개인 도메인은 모두 같은 포스트
+		{"https://blog.naver.com/Foodie/223000000001", PostID{PlatformNaverBlog, "foodie", "223000000001"}, "https://blog.naver.com/foodie/223000000001"},
+		{"https://m.blog.naver.com/foodie/223000000001", PostID{PlatformNaverBlog, "foodie", "223000000001"}, "https://blog.naver.com/foodie/223000000001"},
+		{"https://blog.naver.com/PostView.naver?blogId=foodie&logNo=223000000001", PostID{PlatformNaverBlog, "foodie", "223000000001"}, "https://blog.naver.com/foodie/223000000001"},
+		{"blog.naver.com/foodie?Redirect=Log&logNo=223000000001", PostID{PlatformNaverBlog, "foodie", "223000000001"}, "https://blog.naver.com/foodie/223000000001"},
+		{"http://foodie.blog.me/223000000001", PostID{PlatformNaverBlog, "foodie", "223000000001"}, "https://blog.naver.com/foodie/223000000001"},
+		// 네이버 카페
+		{"https://cafe.naver.com/ArticleRead.nhn?clubid=10050146&articleid=1234", PostID{PlatformNaverCafe, "10050146", "1234"}, "https://cafe.naver.com/ca-fe/cafes/10050146/articles/1234"},
+		{"https://cafe.naver.com/ca-fe/cafes/10050146/articles/1234", PostID{PlatformNaverCafe, "10050146", "1234"}, "https://cafe.naver.com/ca-fe/cafes/10050146/articles/1234"},
+		{"https://m.cafe.naver.com/ca-fe/web/cafes/10050146/articles/1234?useCafeId=true", PostID{PlatformNaverCafe, "10050146", "1234"}, "https://cafe.naver.com/ca-fe/cafes/10050146/articles/1234"},
+		{"https://cafe.naver.com/joonggonara?iframe_url=/ArticleRead.nhn%3Fclubid=10050146%26articleid=1234", PostID{PlatformNaverCafe, "10050146", "1234"}, "https://cafe.naver.com/ca-fe/cafes/10050146/articles/1234"},
+		{"https://cafe.naver.com/joonggonara/1234", PostID{PlatformNaverCafe, "joonggonara", "1234"}, "https://cafe.naver.com/joonggonara/1234"},
+		// 네이버 포스트
+		{"https://post.naver.com/viewer/postView.naver?volumeNo=35000001&memberNo=1234567", PostID{PlatformNaverPost, "1234567", "35000001"}, "https://post.naver.com/viewer/postView.naver?volumeNo=35000001&memberNo=1234567"},
+		{"https://m.post.naver.com/viewer/postView.naver?memberNo=1234567&volumeNo=35000001", PostID{PlatformNaverPost, "1234567", "35000001"}, "https://post.naver.com/viewer/postView.naver?volumeNo=35000001&memberNo=1234567"},
+		// 티스토리: 글 번호, 모바일, 슬러그
+		{"https://Traveler.tistory.com/123", PostID{PlatformTistory, "traveler", "123"}, "https://traveler.tistory.com/123"},
+		{"https://traveler.tistory.com/m/123", PostID{PlatformTistory, "traveler", "123"}, "https://traveler.tistory.com/123"},
+		{"https://traveler.tistory.com/entry/jeju-trip", PostID{PlatformTistory, "traveler", "jeju-trip"}, "https://traveler.tistory.com/entry/jeju-trip"},
+		// 그 외 웹 페이지: 호스트와 경로, 정렬한 쿼리로 식별
+		{"https://www.brunch.co.kr/@writer/12/", PostID{PlatformWeb, "brunch.co.kr", "/@writer/12"}, "https://brunch.co.kr/@writer/12"},
+		{"https://example.com/post?b=2&a=1", PostID{PlatformWeb, "example.com", "/post?a=1&b=2"}, "https://example.com/post?a=1&b=2"},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePostID(tt.url)
+		if err != nil {
+			t.Errorf("ParsePostID(%q) 에러: %v", tt.url, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParsePostID(%q) = %+v, want %+v", tt.url, got, tt.want)
+		}
+		if canonical := got.CanonicalURL(); canonical != tt.canonical {
+			t.Errorf("ParsePostID(%q).CanonicalURL() = %q, want %q", tt.url, canonical, tt.canonical)
+		}
+
+		// 대표 URL은 같은 PostID로 돌아와야 함
+		if again, err := ParsePostID(got.CanonicalURL()); err != nil || again != got {
+			t.Errorf("ParsePostID(CanonicalURL %q) = %+v, %v, want %+v", got.CanonicalURL(), again, err, got)
+		}
+	}
+}
+
+func TestParsePostIDInvalid(t *testing.T) {
+	for _, invalid := range []string{
+		"",
+		"https://blog.naver.com/foodie", // 글 번호 없음
+		"https://blog.naver.com/foodie/not-a-number",   // 글 번호 형식 아님
+		"https://cafe.naver.com/joonggonara",           // 게시글 번호 없음
+		"https://cafe.naver.com/ca-fe/cafes/10050146",  // 게시글 번호 없음
+		"https://post.naver.com/viewer/postView.naver", // volumeNo, memberNo 없음
+		"https://traveler.tistory.com/category/travel", // 글 주소 아님
+		"https:///no-host",
+	} {
+		if id, err := ParsePostID(invalid); err == nil {
+			t.Errorf("ParsePostID(%q) = %+v, want 에러", invalid, id)
+		}
+	}
+}
@@ -23,6 +23,7 @@ const (
 	SponsorTypeParagraph   SponsorType = "paragraph"   // 첫 문단에서 발견
 	SponsorTypeImage       SponsorType = "image"       // 이미지에서 발견
 	SponsorTypeSticker     SponsorType = "sticker"     // 스티커에서 발견
+	SponsorTypeComment     SponsorType = "comment"     // 댓글에서 발견
 	SponsorTypeUnknown     SponsorType = "unknown"     // 알 수 없는 유형
 )
 
@@ -6,7 +6,12 @@ import (
 )
 
 // RemoveHTMLTags는 문자열에서 HTML 태그를 제거합니다
+// 줄바꿈 태그(<br>)는 앞뒤 문장이 붙지 않도록 공백으로 바꿉니다
 func RemoveHTMLTags(s string) string {
+	// 줄바꿈 태그는 공백으로
+	reBreak := regexp.MustCompile(`(?i)<br\s*/?>`)
+	s = reBreak.ReplaceAllString(s, " ")
+
 	// HTML 태그 정규식
 	re := regexp.MustCompile(`<[^>]*>`)
 	noTags := re.ReplaceAllString(s, "")
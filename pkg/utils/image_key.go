@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	types "github.com/sh5080/ndns-go/pkg/types"
+)
+
+// CanonicalImageURL은 같은 이미지를 가리키는 URL이 같은 값이 되도록 정규화합니다
+// 스킴을 https로 통일하고, 미러 호스트를 대표 호스트로 바꾸며, 프래그먼트를 제거합니다
+// 크기/형태 파라미터는 네이버 이미지 호스트(*.pstatic.net)에서만 제거합니다
+// 해석할 수 없는 URL은 그대로 반환합니다
+func CanonicalImageURL(imageURL string) string {
+	raw := strings.TrimSpace(imageURL)
+	if raw == "" {
+		return ""
+	}
+	if !strings.HasPrefix(raw, "http://") && !strings.HasPrefix(raw, "https://") {
+		raw = "https://" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return imageURL
+	}
+
+	parsed.Scheme = "https"
+	parsed.Host = strings.ToLower(parsed.Host)
+	if host, exists := types.IMAGE_MIRROR_HOSTS[parsed.Host]; exists {
+		parsed.Host = host
+	}
+
+	// 남은 파라미터는 이름 순으로 정렬하여 순서가 달라도 같은 키가 되도록 함
+	query := parsed.Query()
+	if strings.HasSuffix(parsed.Host, types.IMAGE_SIZE_QUERY_HOST_SUFFIX) {
+		for _, param := range types.IMAGE_SIZE_QUERY_PARAMS {
+			query.Del(param)
+		}
+	}
+	parsed.RawQuery = query.Encode()
+	parsed.Fragment = ""
+
+	return parsed.String()
+}
+
+// HashImageFile은 이미지 파일 내용의 SHA-256 해시(16진수)를 반환합니다
+func HashImageFile(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("파일 열기 실패: %v", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("이미지 해시 계산 실패: %v", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
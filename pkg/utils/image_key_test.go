@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCanonicalImageURL(t *testing.T) {
+	tests := []struct {
+		imageURL string
+		want     string
+	}{
+		// 네이버 이미지: 스킴, 미러 호스트, 크기 파라미터, 프래그먼트 정규화
+		{"http://postfiles.pstatic.net/MjAy/image.jpg?type=w773", "https://postfiles.pstatic.net/MjAy/image.jpg"},
+		{"https://blogfiles.pstatic.net/MjAy/image.jpg?type=w80_blur", "https://postfiles.pstatic.net/MjAy/image.jpg"},
+		{"https://MBLOGTHUMB-PHINF.pstatic.net/MjAy/image.jpg?type=w2#top", "https://postfiles.pstatic.net/MjAy/image.jpg"},
+		{"postfiles.pstatic.net/MjAy/image.jpg", "https://postfiles.pstatic.net/MjAy/image.jpg"},
+		{"https://storep-phinf.pstatic.net/sticker/1.png?type=p100_100&b=2&a=1", "https://storep-phinf.pstatic.net/sticker/1.png?a=1&b=2"},
+		// 다른 호스트의 type 파라미터는 다른 이미지를 가리킬 수 있으므로 유지
+		{"https://cdn.example.com/render?type=banner&id=7", "https://cdn.example.com/render?id=7&type=banner"},
+		{"https://example.pstatic.net.evil.com/image.jpg?type=w773", "https://example.pstatic.net.evil.com/image.jpg?type=w773"},
+		// 빈 값과 해석할 수 없는 URL
+		{"", ""},
+		{"   ", ""},
+		{"https://", "https://"},
+	}
+
+	for _, tt := range tests {
+		if got := CanonicalImageURL(tt.imageURL); got != tt.want {
+			t.Errorf("CanonicalImageURL(%q) = %q, want %q", tt.imageURL, got, tt.want)
+		}
+	}
+}
+
+func TestHashImageFile(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.png")
+	second := filepath.Join(dir, "second.png")
+	os.WriteFile(first, []byte("image"), 0o644)
+	os.WriteFile(second, []byte("image"), 0o644)
+
+	hash, err := HashImageFile(first)
+	if err != nil {
+		t.Fatalf("HashImageFile 에러: %v", err)
+	}
+	// sha256("image")
+	if want := "6105d6cc76af400325e94d588ce511be5bfdbb73b437dc51eca43917d7a43e3d"; hash != want {
+		t.Errorf("HashImageFile = %s, want %s", hash, want)
+	}
+
+	// 파일 이름이 달라도 내용이 같으면 같은 해시
+	if other, _ := HashImageFile(second); other != hash {
+		t.Errorf("같은 내용의 해시가 다름: %s, %s", other, hash)
+	}
+
+	if _, err := HashImageFile(filepath.Join(dir, "missing.png")); err == nil {
+		t.Error("없는 파일의 해시 계산이 성공함")
+	}
+}
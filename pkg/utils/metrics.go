@@ -69,6 +69,80 @@ var (
 		[]string{"instance"},
 	)
 
+	// 외부 호스트 차단 감지 메트릭
+	blockedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "crawler_blocked_total",
+			Help: "Total number of times an upstream host blocked or throttled crawling",
+		},
+		[]string{"instance", "host", "reason"},
+	)
+
+	// 캐시 조회 결과 메트릭
+	cacheRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_requests_total",
+			Help: "Total number of cache lookups by result (hit, miss, revalidated, error)",
+		},
+		[]string{"instance", "cache", "result"},
+	)
+
+	// 외부 요청 경로별 요청 결과 메트릭
+	egressRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "egress_requests_total",
+			Help: "Total number of outbound requests by egress route and result (success, failure)",
+		},
+		[]string{"instance", "route", "result"},
+	)
+
+	// 외부 요청 경로별 응답 시간 메트릭
+	egressRequestSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "egress_request_seconds",
+			Help:    "Outbound request duration in seconds by egress route",
+			Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{"instance", "route"},
+	)
+
+	// 외부 요청 경로별 상태 점수 메트릭
+	egressRouteHealth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "egress_route_health",
+			Help: "Health score (recent success rate, 0-1) of each egress route",
+		},
+		[]string{"instance", "route"},
+	)
+
+	// OCR 대기열 길이 메트릭
+	ocrQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ocr_queue_depth",
+			Help: "Number of OCR requests waiting for an OCR worker by priority",
+		},
+		[]string{"instance", "priority"},
+	)
+
+	// OCR 대기 시간 메트릭
+	ocrQueueWaitSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ocr_queue_wait_seconds",
+			Help:    "Time OCR requests waited in the queue before an OCR worker picked them up",
+			Buckets: []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{"instance", "priority"},
+	)
+
+	// OCR 거절 메트릭
+	ocrRejectedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocr_rejected_total",
+			Help: "Total number of OCR requests rejected by priority and reason (queue_full, wait_timeout)",
+		},
+		[]string{"instance", "priority", "reason"},
+	)
+
 	metricsInitialized bool
 	initLock           sync.Mutex
 )
@@ -89,6 +163,14 @@ func InitMetrics() {
 	prometheus.MustRegister(errorTotal)
 	prometheus.MustRegister(serverMetrics)
 	prometheus.MustRegister(ocrProcessingTime)
+	prometheus.MustRegister(blockedTotal)
+	prometheus.MustRegister(cacheRequestsTotal)
+	prometheus.MustRegister(egressRequestsTotal)
+	prometheus.MustRegister(egressRequestSeconds)
+	prometheus.MustRegister(egressRouteHealth)
+	prometheus.MustRegister(ocrQueueDepth)
+	prometheus.MustRegister(ocrQueueWaitSeconds)
+	prometheus.MustRegister(ocrRejectedTotal)
 
 	metricsInitialized = true
 	fmt.Println("Metrics initialized successfully")
@@ -174,3 +256,67 @@ func RecordOcrProcessingTime(duration float64) {
 	instance, _ := GetInstanceName()
 	ocrProcessingTime.WithLabelValues(instance).Observe(duration)
 }
+
+// RecordBlocked records that an upstream host blocked crawling
+func RecordBlocked(host, reason string) {
+	if !metricsInitialized {
+		return
+	}
+	instance, _ := GetInstanceName()
+	blockedTotal.WithLabelValues(instance, host, reason).Inc()
+}
+
+// RecordCacheResult records a cache lookup result
+func RecordCacheResult(cache, result string) {
+	if !metricsInitialized {
+		return
+	}
+	instance, _ := GetInstanceName()
+	cacheRequestsTotal.WithLabelValues(instance, cache, result).Inc()
+}
+
+// RecordEgressRequest records an outbound request result and its duration per egress route
+func RecordEgressRequest(route, result string, duration float64) {
+	if !metricsInitialized {
+		return
+	}
+	instance, _ := GetInstanceName()
+	egressRequestsTotal.WithLabelValues(instance, route, result).Inc()
+	egressRequestSeconds.WithLabelValues(instance, route).Observe(duration)
+}
+
+// RecordEgressHealth records the health score of an egress route
+func RecordEgressHealth(route string, score float64) {
+	if !metricsInitialized {
+		return
+	}
+	instance, _ := GetInstanceName()
+	egressRouteHealth.WithLabelValues(instance, route).Set(score)
+}
+
+// RecordOCRQueueDepth records the number of OCR requests waiting for a worker
+func RecordOCRQueueDepth(priority string, depth int) {
+	if !metricsInitialized {
+		return
+	}
+	instance, _ := GetInstanceName()
+	ocrQueueDepth.WithLabelValues(instance, priority).Set(float64(depth))
+}
+
+// RecordOCRQueueWait records how long an OCR request waited for a worker
+func RecordOCRQueueWait(priority string, duration float64) {
+	if !metricsInitialized {
+		return
+	}
+	instance, _ := GetInstanceName()
+	ocrQueueWaitSeconds.WithLabelValues(instance, priority).Observe(duration)
+}
+
+// RecordOCRRejected records an OCR request rejected because the queue was saturated
+func RecordOCRRejected(priority, reason string) {
+	if !metricsInitialized {
+		return
+	}
+	instance, _ := GetInstanceName()
+	ocrRejectedTotal.WithLabelValues(instance, priority, reason).Inc()
+}